@@ -0,0 +1,109 @@
+package cache
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// loadBatch represents a set of keys collected from concurrent callers
+// and loaded using a single call to a load many function
+type loadBatch[T any] struct {
+	keys   []any
+	seen   map[string]struct{}
+	done   chan struct{}
+	values map[any]T
+	err    error
+}
+
+// loadBatcher collects the keys requested by concurrent callers during a
+// given window of time in order to load them all at once
+type loadBatcher[T any] struct {
+	mu           sync.Mutex
+	loadManyFunc LoadManyFunction[T]
+	keyGenerator KeyGenerator
+	window       time.Duration
+	maxSize      int
+	current      *loadBatch[T]
+}
+
+func newLoadBatcher[T any](loadManyFunc LoadManyFunction[T], keyGenerator KeyGenerator, window time.Duration, maxSize int) *loadBatcher[T] {
+	return &loadBatcher[T]{
+		loadManyFunc: loadManyFunc,
+		keyGenerator: keyGenerator,
+		window:       window,
+		maxSize:      maxSize,
+	}
+}
+
+// load adds the given keys to the pending batch and waits for it to be
+// loaded. The returned joined value reports whether the keys were added
+// to a batch created by another caller.
+func (b *loadBatcher[T]) load(ctx context.Context, keys []any) (values map[any]T, joined bool, err error) {
+	b.mu.Lock()
+
+	batch := b.current
+	joined = batch != nil
+	if batch == nil {
+		batch = &loadBatch[T]{
+			seen: make(map[string]struct{}),
+			done: make(chan struct{}),
+		}
+		b.current = batch
+
+		time.AfterFunc(b.window, func() {
+			b.dispatch(batch)
+		})
+	}
+
+	for _, key := range keys {
+		k := generateCacheKey(key, b.keyGenerator)
+		if _, ok := batch.seen[k]; !ok {
+			batch.seen[k] = struct{}{}
+			batch.keys = append(batch.keys, key)
+		}
+	}
+
+	full := b.maxSize > 0 && len(batch.keys) >= b.maxSize
+	b.mu.Unlock()
+
+	if full {
+		go b.dispatch(batch)
+	}
+
+	select {
+	case <-batch.done:
+	case <-ctx.Done():
+		return nil, joined, ctx.Err()
+	}
+
+	if batch.err != nil {
+		return nil, joined, batch.err
+	}
+
+	values = make(map[any]T, len(keys))
+	for _, key := range keys {
+		if value, ok := batch.values[key]; ok {
+			values[key] = value
+		}
+	}
+
+	return values, joined, nil
+}
+
+// dispatch loads the given batch, unless it has already been dispatched
+func (b *loadBatcher[T]) dispatch(batch *loadBatch[T]) {
+	b.mu.Lock()
+	if b.current != batch {
+		b.mu.Unlock()
+		return
+	}
+	b.current = nil
+	b.mu.Unlock()
+
+	batch.err = errLoadPanicked
+	defer close(batch.done)
+
+	// The batch is shared by several callers so it cannot depend on their context
+	batch.values, batch.err = b.loadManyFunc(context.Background(), batch.keys)
+}
@@ -0,0 +1,56 @@
+package cache
+
+import (
+	"context"
+
+	"github.com/eko/gocache/v3/store"
+)
+
+// getMany returns the objects of the given keys from the given cache, using a
+// single call when the cache supports it. Keys that are not found are omitted.
+func getMany[T any](ctx context.Context, cache CacheInterface[T], keys []any) (map[any]T, error) {
+	if bulkCache, ok := cache.(BulkCacheInterface[T]); ok {
+		return bulkCache.GetMany(ctx, keys)
+	}
+
+	objects := make(map[any]T, len(keys))
+	for _, key := range keys {
+		if object, err := cache.Get(ctx, key); err == nil {
+			objects[key] = object
+		}
+	}
+
+	return objects, nil
+}
+
+// setMany sets the given items in the given cache, using a single call when
+// the cache supports it
+func setMany[T any](ctx context.Context, cache CacheInterface[T], items map[any]T, options ...store.Option) error {
+	if bulkCache, ok := cache.(BulkCacheInterface[T]); ok {
+		return bulkCache.SetMany(ctx, items, options...)
+	}
+
+	for key, object := range items {
+		if err := cache.Set(ctx, key, object, options...); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// deleteMany removes the given keys from the given cache, using a single call
+// when the cache supports it
+func deleteMany[T any](ctx context.Context, cache CacheInterface[T], keys []any) error {
+	if bulkCache, ok := cache.(BulkCacheInterface[T]); ok {
+		return bulkCache.DeleteMany(ctx, keys)
+	}
+
+	for _, key := range keys {
+		if err := cache.Delete(ctx, key); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -2,9 +2,8 @@ package cache
 
 import (
 	"context"
-	"crypto"
-	"fmt"
-	"reflect"
+	"errors"
+	"sync"
 	"time"
 
 	"github.com/eko/gocache/v3/codec"
@@ -18,13 +17,20 @@ const (
 
 // Cache represents the configuration needed by a cache
 type Cache[T any] struct {
-	codec codec.CodecInterface
+	codec   codec.CodecInterface
+	options *cacheOptions
+
+	renewMu   sync.Mutex
+	renewedAt map[string]time.Time
+	prunedAt  time.Time
 }
 
 // New instantiates a new cache entry
-func New[T any](store store.StoreInterface) *Cache[T] {
+func New[T any](store store.StoreInterface, options ...CacheOption) *Cache[T] {
 	return &Cache[T]{
-		codec: codec.New(store),
+		codec:     codec.New(store),
+		options:   applyCacheOptions(options...),
+		renewedAt: make(map[string]time.Time),
 	}
 }
 
@@ -37,11 +43,14 @@ func (c *Cache[T]) Get(ctx context.Context, key any) (T, error) {
 		return *new(T), err
 	}
 
-	if v, ok := value.(T); ok {
-		return v, nil
+	object, err := convertValue[T](key, value, c.options.converter)
+	if err != nil {
+		return object, err
 	}
 
-	return *new(T), nil
+	c.renew(ctx, cacheKey, value)
+
+	return object, nil
 }
 
 // GetWithTTL returns the object stored in cache and its corresponding TTL
@@ -53,11 +62,16 @@ func (c *Cache[T]) GetWithTTL(ctx context.Context, key any) (T, time.Duration, e
 		return *new(T), duration, err
 	}
 
-	if v, ok := value.(T); ok {
-		return v, duration, nil
+	object, err := convertValue[T](key, value, c.options.converter)
+	if err != nil {
+		return object, duration, err
 	}
 
-	return *new(T), duration, nil
+	if c.renew(ctx, cacheKey, value) {
+		duration = c.options.slidingTTL
+	}
+
+	return object, duration, nil
 }
 
 // Set populates the cache item using the given key
@@ -66,6 +80,178 @@ func (c *Cache[T]) Set(ctx context.Context, key any, object T, options ...store.
 	return c.codec.Set(ctx, cacheKey, object, options...)
 }
 
+// GetMany returns the objects stored in cache for the given keys. Keys that
+// are not found are omitted from the returned map.
+func (c *Cache[T]) GetMany(ctx context.Context, keys []any) (map[any]T, error) {
+	cacheKeys := make([]any, len(keys))
+	for i, key := range keys {
+		cacheKeys[i] = c.getCacheKey(key)
+	}
+
+	values, err := c.codec.GetMany(ctx, cacheKeys)
+	if err != nil {
+		return nil, err
+	}
+
+	objects := make(map[any]T, len(values))
+	for i, key := range keys {
+		value, ok := values[cacheKeys[i]]
+		if !ok {
+			continue
+		}
+
+		object, err := convertValue[T](key, value, c.options.converter)
+		if err != nil {
+			return nil, err
+		}
+
+		objects[key] = object
+	}
+
+	return objects, nil
+}
+
+// GetManyWithTTL returns the objects stored in cache for the given keys along
+// with their remaining TTL, using a single call when the store supports it.
+// Keys that are not found are omitted from the returned maps.
+func (c *Cache[T]) GetManyWithTTL(ctx context.Context, keys []any) (map[any]T, map[any]time.Duration, error) {
+	cacheKeys := make([]any, len(keys))
+	for i, key := range keys {
+		cacheKeys[i] = c.getCacheKey(key)
+	}
+
+	values, durations, err := c.codec.GetManyWithTTL(ctx, cacheKeys)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	objects := make(map[any]T, len(values))
+	ttls := make(map[any]time.Duration, len(values))
+	for i, key := range keys {
+		value, ok := values[cacheKeys[i]]
+		if !ok {
+			continue
+		}
+
+		object, err := convertValue[T](key, value, c.options.converter)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		objects[key] = object
+		ttls[key] = durations[cacheKeys[i]]
+	}
+
+	return objects, ttls, nil
+}
+
+// SetMany populates the cache items using the given keys
+func (c *Cache[T]) SetMany(ctx context.Context, items map[any]T, options ...store.Option) error {
+	values := make(map[any]any, len(items))
+	for key, object := range items {
+		values[c.getCacheKey(key)] = object
+	}
+
+	return c.codec.SetMany(ctx, values, options...)
+}
+
+// DeleteMany removes the cache items using the given keys
+func (c *Cache[T]) DeleteMany(ctx context.Context, keys []any) error {
+	cacheKeys := make([]any, len(keys))
+	for i, key := range keys {
+		cacheKeys[i] = c.getCacheKey(key)
+	}
+
+	return c.codec.DeleteMany(ctx, cacheKeys)
+}
+
+// SetIfNotExists populates the cache item using the given key only when it does
+// not exist yet. It returns a store.AlreadyExists error otherwise.
+func (c *Cache[T]) SetIfNotExists(ctx context.Context, key any, object T, options ...store.Option) error {
+	cacheKey := c.getCacheKey(key)
+	return c.codec.SetIfNotExists(ctx, cacheKey, object, options...)
+}
+
+// GetWithVersion returns the object stored in cache and its version, to be
+// given to SetIfVersion
+func (c *Cache[T]) GetWithVersion(ctx context.Context, key any) (T, store.Version, error) {
+	cacheKey := c.getCacheKey(key)
+
+	value, version, err := c.codec.GetWithVersion(ctx, cacheKey)
+	if err != nil {
+		return *new(T), version, err
+	}
+
+	object, err := convertValue[T](key, value, c.options.converter)
+	if err != nil {
+		return object, version, err
+	}
+
+	return object, version, nil
+}
+
+// SetIfVersion populates the cache item using the given key only when it has not
+// changed since the given version. It returns a store.VersionConflict error otherwise.
+func (c *Cache[T]) SetIfVersion(ctx context.Context, key any, object T, version store.Version, options ...store.Option) error {
+	cacheKey := c.getCacheKey(key)
+	return c.codec.SetIfVersion(ctx, cacheKey, object, version, options...)
+}
+
+// DeleteIfVersion removes the cache item using the given key only when it has
+// not changed since the given version
+func (c *Cache[T]) DeleteIfVersion(ctx context.Context, key any, version store.Version) error {
+	cacheKey := c.getCacheKey(key)
+	return c.codec.DeleteIfVersion(ctx, cacheKey, version)
+}
+
+// Exists returns whether the cache item of the given key exists
+func (c *Cache[T]) Exists(ctx context.Context, key any) (bool, error) {
+	cacheKey := c.getCacheKey(key)
+	return c.codec.Exists(ctx, cacheKey)
+}
+
+// Touch updates the expiration of the cache item of the given key
+func (c *Cache[T]) Touch(ctx context.Context, key any, ttl time.Duration) error {
+	cacheKey := c.getCacheKey(key)
+	return c.codec.Touch(ctx, cacheKey, ttl)
+}
+
+// Scan calls fn for each cache key matching the given pattern. Keys are given
+// as stored, so keys which are not strings appear as their checksum.
+func (c *Cache[T]) Scan(ctx context.Context, pattern string, fn func(key string) error) error {
+	return c.codec.Scan(ctx, pattern, fn)
+}
+
+// Increment atomically adds delta to the counter stored at the given key and
+// returns its new value. Missing counters are created with the given options.
+func (c *Cache[T]) Increment(ctx context.Context, key any, delta int64, options ...store.Option) (int64, error) {
+	cacheKey := c.getCacheKey(key)
+	return c.codec.Increment(ctx, cacheKey, delta, options...)
+}
+
+// Decrement atomically subtracts delta from the counter stored at the given key
+// and returns its new value
+func (c *Cache[T]) Decrement(ctx context.Context, key any, delta int64, options ...store.Option) (int64, error) {
+	return c.Increment(ctx, key, -delta, options...)
+}
+
+// GetCounter returns the counter stored at the given key without changing it
+func (c *Cache[T]) GetCounter(ctx context.Context, key any) (int64, error) {
+	value, err := c.codec.Get(ctx, c.getCacheKey(key))
+	if err != nil {
+		return 0, err
+	}
+
+	return store.CounterValue(value)
+}
+
+// SetCounterIfNotExists initializes the counter stored at the given key only
+// when it does not exist yet. It returns a store.AlreadyExists error otherwise.
+func (c *Cache[T]) SetCounterIfNotExists(ctx context.Context, key any, counter int64, options ...store.Option) error {
+	cacheKey := c.getCacheKey(key)
+	return c.codec.SetIfNotExists(ctx, cacheKey, store.FormatCounter(counter), options...)
+}
+
 // Delete removes the cache item using the given key
 func (c *Cache[T]) Delete(ctx context.Context, key any) error {
 	cacheKey := c.getCacheKey(key)
@@ -82,6 +268,11 @@ func (c *Cache[T]) Clear(ctx context.Context) error {
 	return c.codec.Clear(ctx)
 }
 
+// GetKeyGenerator returns the key generator of the cache
+func (c *Cache[T]) GetKeyGenerator() KeyGenerator {
+	return c.options.keyGenerator
+}
+
 // GetCodec returns the current codec
 func (c *Cache[T]) GetCodec() codec.CodecInterface {
 	return c.codec
@@ -92,26 +283,89 @@ func (c *Cache[T]) GetType() string {
 	return CacheType
 }
 
-// getCacheKey returns the cache key for the given key object by returning
-// the key if type is string or by computing a checksum of key structure
-// if its type is other than string
+// renew extends the expiration of the given cache item when sliding expiration
+// is enabled and returns whether it was renewed. Errors are ignored as the
+// value has already been retrieved.
+func (c *Cache[T]) renew(ctx context.Context, cacheKey string, value any) bool {
+	if c.options.slidingTTL <= 0 || !c.shouldRenew(cacheKey) {
+		return false
+	}
+
+	err := c.codec.Touch(ctx, cacheKey, c.options.slidingTTL)
+	if errors.Is(err, store.ErrUnsupportedOperation) {
+		err = c.codec.Set(ctx, cacheKey, value, store.WithExpiration(c.options.slidingTTL))
+	}
+
+	if err != nil {
+		c.renewMu.Lock()
+		delete(c.renewedAt, cacheKey)
+		c.renewMu.Unlock()
+
+		return false
+	}
+
+	return true
+}
+
+// shouldRenew returns whether the given cache key was not renewed during the
+// minimum refresh interval and records its renewal if so
+func (c *Cache[T]) shouldRenew(cacheKey string) bool {
+	interval := c.options.slidingRefreshInterval
+	if interval <= 0 {
+		return true
+	}
+
+	now := time.Now()
+
+	c.renewMu.Lock()
+	defer c.renewMu.Unlock()
+
+	// Forget keys renewed before the interval so that the map only holds
+	// the keys read recently
+	if now.Sub(c.prunedAt) >= interval {
+		for key, renewedAt := range c.renewedAt {
+			if now.Sub(renewedAt) >= interval {
+				delete(c.renewedAt, key)
+			}
+		}
+		c.prunedAt = now
+	}
+
+	if renewedAt, ok := c.renewedAt[cacheKey]; ok && now.Sub(renewedAt) < interval {
+		return false
+	}
+
+	c.renewedAt[cacheKey] = now
+
+	return true
+}
+
+// getCacheKey returns the cache key for the given key object using the key
+// generator of the cache
 func (c *Cache[T]) getCacheKey(key any) string {
+	return generateCacheKey(key, c.options.keyGenerator)
+}
+
+// getKeyGenerator returns the key generator of the given cache, or the
+// default one when it does not expose it
+func getKeyGenerator(cache any) KeyGenerator {
+	if keyGeneratorCache, ok := cache.(KeyGeneratorCacheInterface); ok {
+		return keyGeneratorCache.GetKeyGenerator()
+	}
+
+	return ChecksumKeyGenerator
+}
+
+// generateCacheKey returns the cache key for the given key object by returning
+// the key if type is string, by calling its GetCacheKey method if it implements
+// CacheKeyGenerator or by using the given key generator otherwise
+func generateCacheKey(key any, keyGenerator KeyGenerator) string {
 	switch v := key.(type) {
 	case string:
 		return v
 	case CacheKeyGenerator:
 		return v.GetCacheKey()
 	default:
-		return checksum(key)
+		return keyGenerator(key)
 	}
 }
-
-// checksum hashes a given object into a string
-func checksum(object any) string {
-	digester := crypto.MD5.New()
-	fmt.Fprint(digester, reflect.TypeOf(object))
-	fmt.Fprint(digester, object)
-	hash := digester.Sum(nil)
-
-	return fmt.Sprintf("%x", hash)
-}
@@ -0,0 +1,59 @@
+package cache
+
+import (
+	"time"
+)
+
+// CacheOption represents a cache option function.
+type CacheOption func(o *cacheOptions)
+
+type cacheOptions struct {
+	slidingTTL             time.Duration
+	slidingRefreshInterval time.Duration
+	keyGenerator           KeyGenerator
+	converter              Converter
+}
+
+func applyCacheOptions(opts ...CacheOption) *cacheOptions {
+	o := &cacheOptions{
+		keyGenerator: ChecksumKeyGenerator,
+	}
+
+	for _, opt := range opts {
+		opt(o)
+	}
+
+	return o
+}
+
+// WithSlidingExpiration allows to make values expire the given duration after
+// they were last read instead of after they were written: each successful Get
+// or GetWithTTL renews the expiration, natively when the store handles Touch or
+// by setting the value again otherwise. A same key is renewed at most once per
+// minRefreshInterval so that hot keys do not trigger a write on every read.
+func WithSlidingExpiration(ttl time.Duration, minRefreshInterval time.Duration) CacheOption {
+	return func(o *cacheOptions) {
+		o.slidingTTL = ttl
+		o.slidingRefreshInterval = minRefreshInterval
+	}
+}
+
+// WithConverter allows to convert the values retrieved from the store which
+// do not have the type of the cache, for instance using StringBytesConverter.
+// Without converter, or when the converter fails, a TypeMismatchError is
+// returned.
+func WithConverter(converter Converter) CacheOption {
+	return func(o *cacheOptions) {
+		o.converter = converter
+	}
+}
+
+// WithKeyGenerator allows to specify how the cache keys of key objects which
+// are neither strings nor CacheKeyGenerator implementations are computed,
+// for instance using SHA256KeyGenerator, XXHashKeyGenerator or
+// ReadableKeyGenerator. ChecksumKeyGenerator is used by default.
+func WithKeyGenerator(keyGenerator KeyGenerator) CacheOption {
+	return func(o *cacheOptions) {
+		o.keyGenerator = keyGenerator
+	}
+}
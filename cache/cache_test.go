@@ -53,6 +53,175 @@ func TestCacheSet(t *testing.T) {
 	assert.Nil(t, err)
 }
 
+func TestCacheGetMany(t *testing.T) {
+	// Given
+	ctrl := gomock.NewController(t)
+
+	ctx := context.Background()
+
+	mockedStore := mocksStore.NewMockStoreInterface(ctrl)
+	mockedStore.EXPECT().Get(ctx, "key-1").Return("value 1", nil)
+	mockedStore.EXPECT().Get(ctx, "key-2").Return(nil, errors.New("unable to find in store"))
+
+	cache := New[string](mockedStore)
+
+	// When
+	values, err := cache.GetMany(ctx, []any{"key-1", "key-2"})
+
+	// Then
+	assert.Nil(t, err)
+	assert.Equal(t, map[any]string{"key-1": "value 1"}, values)
+	assert.Equal(t, 1, cache.GetCodec().GetStats().Hits)
+	assert.Equal(t, 1, cache.GetCodec().GetStats().Miss)
+}
+
+func TestCacheGetManyWithTTL(t *testing.T) {
+	// Given
+	ctrl := gomock.NewController(t)
+
+	ctx := context.Background()
+
+	mockedStore := mocksStore.NewMockStoreInterface(ctrl)
+	mockedStore.EXPECT().GetWithTTL(ctx, "key-1").Return("value 1", 5*time.Second, nil)
+	mockedStore.EXPECT().GetWithTTL(ctx, "key-2").Return(nil, 0*time.Second, errors.New("unable to find in store"))
+
+	cache := New[string](mockedStore)
+
+	// When
+	values, ttls, err := cache.GetManyWithTTL(ctx, []any{"key-1", "key-2"})
+
+	// Then
+	assert.Nil(t, err)
+	assert.Equal(t, map[any]string{"key-1": "value 1"}, values)
+	assert.Equal(t, map[any]time.Duration{"key-1": 5 * time.Second}, ttls)
+	assert.Equal(t, 1, cache.GetCodec().GetStats().Hits)
+	assert.Equal(t, 1, cache.GetCodec().GetStats().Miss)
+}
+
+func TestCacheSetMany(t *testing.T) {
+	// Given
+	ctrl := gomock.NewController(t)
+
+	ctx := context.Background()
+
+	mockedStore := mocksStore.NewMockStoreInterface(ctrl)
+	mockedStore.EXPECT().Set(ctx, "key-1", "value 1", store.OptionsMatcher{
+		Expiration: 5 * time.Second,
+	}).Return(nil)
+
+	cache := New[string](mockedStore)
+
+	// When
+	err := cache.SetMany(ctx, map[any]string{"key-1": "value 1"}, store.WithExpiration(5*time.Second))
+
+	// Then
+	assert.Nil(t, err)
+}
+
+func TestCacheSetIfNotExists(t *testing.T) {
+	// Given
+	ctrl := gomock.NewController(t)
+
+	ctx := context.Background()
+
+	conditionalStore := mocksStore.NewMockConditionalStoreInterface(ctrl)
+	conditionalStore.EXPECT().SetIfNotExists(ctx, "my-key", "my-value", store.OptionsMatcher{
+		Expiration: 5 * time.Second,
+	}).Return(nil)
+
+	cache := New[string](&struct {
+		*mocksStore.MockStoreInterface
+		*mocksStore.MockConditionalStoreInterface
+	}{
+		mocksStore.NewMockStoreInterface(ctrl),
+		conditionalStore,
+	})
+
+	// When
+	err := cache.SetIfNotExists(ctx, "my-key", "my-value", store.WithExpiration(5*time.Second))
+
+	// Then
+	assert.Nil(t, err)
+}
+
+func TestCacheGetWithVersion(t *testing.T) {
+	// Given
+	ctrl := gomock.NewController(t)
+
+	ctx := context.Background()
+
+	versionedStore := mocksStore.NewMockVersionedStoreInterface(ctrl)
+	versionedStore.EXPECT().GetWithVersion(ctx, "my-key").Return("my-value", store.Version{}, nil)
+
+	cache := New[string](&struct {
+		*mocksStore.MockStoreInterface
+		*mocksStore.MockVersionedStoreInterface
+	}{
+		mocksStore.NewMockStoreInterface(ctrl),
+		versionedStore,
+	})
+
+	// When
+	value, version, err := cache.GetWithVersion(ctx, "my-key")
+
+	// Then
+	assert.Nil(t, err)
+	assert.Equal(t, "my-value", value)
+	assert.Equal(t, store.Version{}, version)
+	assert.Equal(t, 1, cache.GetCodec().GetStats().Hits)
+}
+
+func TestCacheExists(t *testing.T) {
+	// Given
+	ctrl := gomock.NewController(t)
+
+	ctx := context.Background()
+
+	existsStore := mocksStore.NewMockExistsStoreInterface(ctrl)
+	existsStore.EXPECT().Exists(ctx, "my-key").Return(true, nil)
+
+	cache := New[string](&struct {
+		*mocksStore.MockStoreInterface
+		*mocksStore.MockExistsStoreInterface
+	}{
+		mocksStore.NewMockStoreInterface(ctrl),
+		existsStore,
+	})
+
+	// When
+	exists, err := cache.Exists(ctx, "my-key")
+
+	// Then
+	assert.Nil(t, err)
+	assert.True(t, exists)
+	assert.Equal(t, 1, cache.GetCodec().GetStats().Hits)
+}
+
+func TestCacheDecrement(t *testing.T) {
+	// Given
+	ctrl := gomock.NewController(t)
+
+	ctx := context.Background()
+
+	counterStore := mocksStore.NewMockCounterStoreInterface(ctrl)
+	counterStore.EXPECT().Increment(ctx, "my-counter", int64(-3)).Return(int64(7), nil)
+
+	cache := New[string](&struct {
+		*mocksStore.MockStoreInterface
+		*mocksStore.MockCounterStoreInterface
+	}{
+		mocksStore.NewMockStoreInterface(ctrl),
+		counterStore,
+	})
+
+	// When
+	counter, err := cache.Decrement(ctx, "my-counter", 3)
+
+	// Then
+	assert.Nil(t, err)
+	assert.Equal(t, int64(7), counter)
+}
+
 func TestCacheSetWhenErrorOccurs(t *testing.T) {
 	// Given
 	ctrl := gomock.NewController(t)
@@ -104,6 +273,78 @@ func TestCacheGet(t *testing.T) {
 	assert.Equal(t, cacheValue, value)
 }
 
+func TestCacheGetWhenTypeMismatch(t *testing.T) {
+	// Given
+	ctrl := gomock.NewController(t)
+
+	ctx := context.Background()
+
+	store := mocksStore.NewMockStoreInterface(ctrl)
+	store.EXPECT().Get(ctx, "my-key").Return("my-value", nil)
+
+	cache := New[[]byte](store)
+
+	// When
+	value, err := cache.Get(ctx, "my-key")
+
+	// Then
+	assert.ErrorIs(t, err, ErrTypeMismatch)
+	assert.Nil(t, value)
+
+	var mismatchErr *TypeMismatchError
+	assert.True(t, errors.As(err, &mismatchErr))
+	assert.Equal(t, "my-key", mismatchErr.Key)
+	assert.Equal(t, "[]uint8", mismatchErr.Expected.String())
+	assert.Equal(t, "string", mismatchErr.Actual.String())
+}
+
+func TestCacheGetWithConverter(t *testing.T) {
+	// Given
+	ctrl := gomock.NewController(t)
+
+	ctx := context.Background()
+
+	store := mocksStore.NewMockStoreInterface(ctrl)
+	store.EXPECT().Get(ctx, "my-key").Return("my-value", nil)
+
+	cache := New[[]byte](store, WithConverter(StringBytesConverter))
+
+	// When
+	value, err := cache.Get(ctx, "my-key")
+
+	// Then
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("my-value"), value)
+}
+
+func TestCacheGetManyWhenTypeMismatch(t *testing.T) {
+	// Given
+	ctrl := gomock.NewController(t)
+
+	ctx := context.Background()
+
+	bulkStore := mocksStore.NewMockBulkStoreInterface(ctrl)
+	bulkStore.EXPECT().GetMany(ctx, []any{"key1", "key2"}).Return(map[any]any{
+		"key1": 1,
+		"key2": 2.5,
+	}, nil)
+
+	cache := New[int](&struct {
+		*mocksStore.MockStoreInterface
+		*mocksStore.MockBulkStoreInterface
+	}{
+		mocksStore.NewMockStoreInterface(ctrl),
+		bulkStore,
+	})
+
+	// When
+	values, err := cache.GetMany(ctx, []any{"key1", "key2"})
+
+	// Then
+	assert.ErrorIs(t, err, ErrTypeMismatch)
+	assert.Nil(t, values)
+}
+
 func TestCacheGetWhenNotFound(t *testing.T) {
 	// Given
 	ctrl := gomock.NewController(t)
@@ -177,6 +418,132 @@ func TestCacheGetWithTTLWhenNotFound(t *testing.T) {
 	assert.Equal(t, expiration, ttl)
 }
 
+func TestCacheGetWithSlidingExpiration(t *testing.T) {
+	// Given
+	ctrl := gomock.NewController(t)
+
+	ctx := context.Background()
+
+	mockedStore := mocksStore.NewMockStoreInterface(ctrl)
+	mockedStore.EXPECT().Get(ctx, "my-key").Return("my-value", nil).Times(2)
+
+	touchStore := mocksStore.NewMockTouchStoreInterface(ctrl)
+	touchStore.EXPECT().Touch(ctx, "my-key", 10*time.Minute).Return(nil)
+
+	cache := New[string](&struct {
+		*mocksStore.MockStoreInterface
+		*mocksStore.MockTouchStoreInterface
+	}{
+		mockedStore,
+		touchStore,
+	}, WithSlidingExpiration(10*time.Minute, time.Minute))
+
+	// When
+	value1, err1 := cache.Get(ctx, "my-key")
+	value2, err2 := cache.Get(ctx, "my-key")
+
+	// Then
+	assert.Nil(t, err1)
+	assert.Nil(t, err2)
+	assert.Equal(t, "my-value", value1)
+	assert.Equal(t, "my-value", value2)
+}
+
+func TestCacheGetWithTTLWithSlidingExpirationWhenTouchIsUnsupported(t *testing.T) {
+	// Given
+	ctrl := gomock.NewController(t)
+
+	ctx := context.Background()
+
+	mockedStore := mocksStore.NewMockStoreInterface(ctrl)
+	mockedStore.EXPECT().GetWithTTL(ctx, "my-key").Return("my-value", time.Second, nil)
+	mockedStore.EXPECT().Set(ctx, "my-key", "my-value", store.OptionsMatcher{
+		Expiration: 10 * time.Minute,
+	}).Return(nil)
+
+	cache := New[string](mockedStore, WithSlidingExpiration(10*time.Minute, time.Minute))
+
+	// When
+	value, ttl, err := cache.GetWithTTL(ctx, "my-key")
+
+	// Then
+	assert.Nil(t, err)
+	assert.Equal(t, "my-value", value)
+	assert.Equal(t, 10*time.Minute, ttl)
+}
+
+func TestCacheGetWithSlidingExpirationWhenRenewFails(t *testing.T) {
+	// Given
+	ctrl := gomock.NewController(t)
+
+	ctx := context.Background()
+
+	mockedStore := mocksStore.NewMockStoreInterface(ctrl)
+	mockedStore.EXPECT().Get(ctx, "my-key").Return("my-value", nil).Times(2)
+
+	touchStore := mocksStore.NewMockTouchStoreInterface(ctrl)
+	touchStore.EXPECT().Touch(ctx, "my-key", 10*time.Minute).Return(errors.New("unexpected error")).Times(2)
+
+	cache := New[string](&struct {
+		*mocksStore.MockStoreInterface
+		*mocksStore.MockTouchStoreInterface
+	}{
+		mockedStore,
+		touchStore,
+	}, WithSlidingExpiration(10*time.Minute, time.Minute))
+
+	// When
+	_, err1 := cache.Get(ctx, "my-key")
+	_, err2 := cache.Get(ctx, "my-key")
+
+	// Then
+	assert.Nil(t, err1)
+	assert.Nil(t, err2)
+}
+
+func TestCacheGetCounter(t *testing.T) {
+	// Given
+	ctrl := gomock.NewController(t)
+
+	ctx := context.Background()
+
+	store1 := mocksStore.NewMockStoreInterface(ctrl)
+	store1.EXPECT().Get(ctx, "my-counter").Return([]byte("12"), nil)
+
+	cache := New[string](store1)
+
+	// When
+	counter, err := cache.GetCounter(ctx, "my-counter")
+
+	// Then
+	assert.Nil(t, err)
+	assert.Equal(t, int64(12), counter)
+}
+
+func TestCacheSetCounterIfNotExists(t *testing.T) {
+	// Given
+	ctrl := gomock.NewController(t)
+
+	ctx := context.Background()
+
+	conditionalStore := mocksStore.NewMockConditionalStoreInterface(ctrl)
+	conditionalStore.EXPECT().SetIfNotExists(ctx, "my-counter", []byte("12")).Return(nil)
+
+	cache := New[string](&struct {
+		*mocksStore.MockStoreInterface
+		*mocksStore.MockConditionalStoreInterface
+	}{
+		mocksStore.NewMockStoreInterface(ctrl),
+		conditionalStore,
+	})
+
+	// When
+	err := cache.SetCounterIfNotExists(ctx, "my-counter", 12)
+
+	// Then
+	assert.Nil(t, err)
+}
+
 func TestCacheGetCodec(t *testing.T) {
 	// Given
 	ctrl := gomock.NewController(t)
@@ -263,6 +630,27 @@ func TestCacheGetCacheKeyWhenKeyImplementsGenerator(t *testing.T) {
 	assert.Equal(t, "my-generated-key", generatedKey)
 }
 
+func TestCacheGetCacheKeyWithKeyGenerator(t *testing.T) {
+	// Given
+	ctrl := gomock.NewController(t)
+
+	store := mocksStore.NewMockStoreInterface(ctrl)
+
+	cache := New[any](store, WithKeyGenerator(ReadableKeyGenerator))
+
+	// When
+	structKey := cache.getCacheKey(&struct {
+		Hello string
+	}{
+		Hello: "world",
+	})
+	generatedKey := cache.getCacheKey(&StructWithGenerator{})
+
+	// Then
+	assert.Equal(t, "struct { Hello string }:Hello=world", structKey)
+	assert.Equal(t, "my-generated-key", generatedKey)
+}
+
 func TestCacheDelete(t *testing.T) {
 	// Given
 	ctrl := gomock.NewController(t)
@@ -3,7 +3,7 @@ package cache
 import (
 	"context"
 	"errors"
-	"fmt"
+	"sync"
 	"time"
 
 	"github.com/eko/gocache/v3/store"
@@ -14,111 +14,671 @@ const (
 	ChainType = "chain"
 )
 
+// chainKeyValue is a value to be set into the cache layers from index from
+// to index until (excluded) in background, unless its key has changed since
+// the ticket was taken. Items having a flushed channel only close it, once the
+// items sent before them have been set.
 type chainKeyValue[T any] struct {
-	key       any
-	value     T
-	ttl       time.Duration
-	storeType *string
+	key     any
+	value   T
+	options []store.Option
+	from    int
+	until   int
+	ticket  backfillTicket
+	flushed chan struct{}
 }
 
 // ChainCache represents the configuration needed by a cache aggregator
 type ChainCache[T any] struct {
 	caches     []SetterCacheInterface[T]
+	options    *chainOptions
+	backfills  *backfillTracker
 	setChannel chan *chainKeyValue[T]
+	setterWg   *sync.WaitGroup
+	closeMtx   sync.RWMutex
+	closed     bool
 }
 
 // NewChain instantiates a new cache aggregator
 func NewChain[T any](caches ...SetterCacheInterface[T]) *ChainCache[T] {
+	return NewChainWithOptions(caches)
+}
+
+// NewChainWithOptions instantiates a new cache aggregator using the given
+// write and backfill policies and per-layer options
+func NewChainWithOptions[T any](caches []SetterCacheInterface[T], options ...ChainOption) *ChainCache[T] {
 	chain := &ChainCache[T]{
 		caches:     caches,
+		options:    applyChainOptions(options...),
 		setChannel: make(chan *chainKeyValue[T], 10000),
+		setterWg:   &sync.WaitGroup{},
 	}
+	chain.backfills = newBackfillTracker(chain.GetKeyGenerator())
 
+	chain.setterWg.Add(1)
 	go chain.setter()
 
 	return chain
 }
 
-// setter sets a value in available caches, until a given cache layer
+// setter sets the values sent to the set channel into their cache layers
 func (c *ChainCache[T]) setter() {
+	defer c.setterWg.Done()
+
 	for item := range c.setChannel {
-		for _, cache := range c.caches {
-			if item.storeType != nil && *item.storeType == cache.GetCodec().GetStore().GetType() {
-				break
+		if item.flushed != nil {
+			close(item.flushed)
+			continue
+		}
+
+		c.setItem(item)
+	}
+}
+
+// setItem sets the given item into its cache layers, unless its key has
+// changed since its ticket was taken
+func (c *ChainCache[T]) setItem(item *chainKeyValue[T]) {
+	c.backfills.run(item.ticket, func() {
+		for layer := item.from; layer < item.until; layer++ {
+			c.setLayer(context.Background(), layer, item.key, item.value, item.options)
+		}
+	})
+}
+
+// enqueue sends the given item to the set channel, or sets it synchronously
+// once the chained cache has been closed
+func (c *ChainCache[T]) enqueue(item *chainKeyValue[T]) {
+	c.closeMtx.RLock()
+	if !c.closed {
+		c.setChannel <- item
+		c.closeMtx.RUnlock()
+		return
+	}
+	c.closeMtx.RUnlock()
+
+	c.setItem(item)
+}
+
+// setLayer sets a value into the cache layer at the given index, using the
+// given options transformed by the layer option transformers
+func (c *ChainCache[T]) setLayer(ctx context.Context, layer int, key any, object T, options []store.Option) error {
+	return c.caches[layer].Set(ctx, key, object, c.options.layerOptions(layer, object, options)...)
+}
+
+// setManyLayer sets values into the cache layer at the given index. Values
+// are set one by one when the layer has option transformers, as they may
+// depend on each value.
+func (c *ChainCache[T]) setManyLayer(ctx context.Context, layer int, items map[any]T, options []store.Option) error {
+	if len(c.options.layerTransformers[layer]) == 0 {
+		return setMany[T](ctx, c.caches[layer], items, options...)
+	}
+
+	for key, object := range items {
+		if err := c.setLayer(ctx, layer, key, object, options); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// backfill sets a value found in the cache layer at the given index back
+// into the upper layers, according to the backfill policy. The value is
+// discarded if its key has changed since the given ticket was taken, before
+// reading it.
+func (c *ChainCache[T]) backfill(ctx context.Context, key any, object T, ttl time.Duration, layer int, ticket backfillTicket) {
+	if layer == 0 {
+		c.backfills.release(ticket)
+		return
+	}
+
+	options := []store.Option{store.WithExpiration(ttl)}
+
+	if c.options.backfillPolicy == BackfillSync {
+		c.backfills.run(ticket, func() {
+			for upper := 0; upper < layer; upper++ {
+				c.setLayer(ctx, upper, key, object, options)
+			}
+		})
+		return
+	}
+
+	c.enqueue(&chainKeyValue[T]{key: key, value: object, options: options, from: 0, until: layer, ticket: ticket})
+}
+
+// write calls set with the cache layers to write according to the write
+// policy, and invalidate with the upper layers when writing around them. It
+// returns a ChainError holding the errors of the layers which failed
+// synchronously.
+func (c *ChainCache[T]) write(ctx context.Context, what string, set func(ctx context.Context, layer int) error, invalidate func(ctx context.Context, cache SetterCacheInterface[T]) error) error {
+	if len(c.caches) == 0 {
+		return nil
+	}
+
+	last := len(c.caches) - 1
+	from, until := 0, len(c.caches)
+	switch c.options.writePolicy {
+	case WriteLastOnly, WriteAround:
+		from = last
+	case WriteAsync:
+		until = 1
+	}
+
+	errs := []*LayerError{}
+	for layer := from; layer < until; layer++ {
+		if err := set(ctx, layer); err != nil {
+			errs = append(errs, c.layerError(layer, "set "+what+" into", err))
+			if c.options.errorPolicy == FailFast {
+				return newChainError(errs)
+			}
+		}
+	}
+
+	if c.options.writePolicy == WriteAround {
+		for layer := 0; layer < last; layer++ {
+			err := invalidate(ctx, c.caches[layer])
+			if err != nil && !errors.Is(err, &store.NotFound{}) {
+				errs = append(errs, c.layerError(layer, "invalidate "+what+" in", err))
+				if c.options.errorPolicy == FailFast {
+					return newChainError(errs)
+				}
 			}
+		}
+	}
+
+	return newChainError(errs)
+}
 
-			cache.Set(context.Background(), item.key, item.value, store.WithExpiration(item.ttl))
+// each calls fn with the index of each cache layer and returns a ChainError
+// holding the errors of the failing ones. It stops at the first failing layer
+// when the error policy is FailFast.
+func (c *ChainCache[T]) each(action string, fn func(layer int) error) error {
+	errs := []*LayerError{}
+	for layer := range c.caches {
+		if err := fn(layer); err != nil {
+			errs = append(errs, c.layerError(layer, action, err))
+			if c.options.errorPolicy == FailFast {
+				break
+			}
 		}
 	}
+
+	return newChainError(errs)
+}
+
+// layerError returns the error of the cache layer at the given index
+func (c *ChainCache[T]) layerError(layer int, action string, err error) *LayerError {
+	return &LayerError{
+		Layer:     layer,
+		StoreType: c.caches[layer].GetCodec().GetStore().GetType(),
+		Err:       err,
+		action:    action,
+	}
 }
 
 // Get returns the object stored in cache if it exists
 func (c *ChainCache[T]) Get(ctx context.Context, key any) (T, error) {
+	object, _, err := c.GetWithTTL(ctx, key)
+	return object, err
+}
+
+// GetWithTTL returns the object stored in cache and its remaining TTL in the
+// first cache layer holding it, if it exists
+func (c *ChainCache[T]) GetWithTTL(ctx context.Context, key any) (T, time.Duration, error) {
 	var object T
 	var err error
 	var ttl time.Duration
 
-	for _, cache := range c.caches {
-		storeType := cache.GetCodec().GetStore().GetType()
+	ticket := c.backfills.acquire(key)
+
+	for i, cache := range c.caches {
 		object, ttl, err = cache.GetWithTTL(ctx, key)
 		if err == nil {
-			// Set the value back until this cache layer
-			c.setChannel <- &chainKeyValue[T]{key, object, ttl, &storeType}
-			return object, nil
+			// Set the value back into the upper cache layers
+			c.backfill(ctx, key, object, ttl, i, ticket)
+			return object, ttl, nil
 		}
 	}
 
-	return object, err
+	c.backfills.release(ticket)
+
+	return object, 0, err
 }
 
-// Set sets a value in available caches
+// Set sets a value in the cache layers according to the write policy
 func (c *ChainCache[T]) Set(ctx context.Context, key any, object T, options ...store.Option) error {
-	errs := []error{}
-	for _, cache := range c.caches {
-		err := cache.Set(ctx, key, object, options...)
+	writeAsync := c.options.writePolicy == WriteAsync && len(c.caches) > 1
+
+	var err error
+	tickets := c.backfills.outdateAndAcquire(func() {
+		err = c.write(ctx, "item", func(ctx context.Context, layer int) error {
+			return c.setLayer(ctx, layer, key, object, options)
+		}, func(ctx context.Context, cache SetterCacheInterface[T]) error {
+			return cache.Delete(ctx, key)
+		})
+	}, writeAsync, key)
+
+	if writeAsync {
+		c.enqueue(&chainKeyValue[T]{key: key, value: object, options: options, from: 1, until: len(c.caches), ticket: tickets[0]})
+	}
+
+	return err
+}
+
+// GetMany returns the objects stored in caches for the given keys, looking for
+// the missing ones in the next cache layers. Keys that are not found in any
+// cache are omitted from the returned map.
+func (c *ChainCache[T]) GetMany(ctx context.Context, keys []any) (map[any]T, error) {
+	var err error
+
+	objects := make(map[any]T, len(keys))
+	missingKeys := keys
+
+	tickets := make(map[any]backfillTicket, len(keys))
+	for _, key := range keys {
+		if _, ok := tickets[key]; !ok {
+			tickets[key] = c.backfills.acquire(key)
+		}
+	}
+
+	for i, cache := range c.caches {
+		if len(missingKeys) == 0 {
+			break
+		}
+
+		var values map[any]T
+		var ttls map[any]time.Duration
+		values, ttls, err = c.getManyWithTTL(ctx, i, cache, missingKeys)
 		if err != nil {
-			storeType := cache.GetCodec().GetStore().GetType()
-			errs = append(errs, fmt.Errorf("Unable to set item into cache with store '%s': %v", storeType, err))
+			continue
 		}
+
+		remainingKeys := []any{}
+
+		for _, key := range missingKeys {
+			object, ok := values[key]
+			if !ok {
+				remainingKeys = append(remainingKeys, key)
+				continue
+			}
+
+			objects[key] = object
+
+			// Set the value back into the upper cache layers, unless its
+			// TTL is unknown
+			ttl, ok := ttls[key]
+			if !ok {
+				c.backfills.release(tickets[key])
+				continue
+			}
+			c.backfill(ctx, key, object, ttl, i, tickets[key])
+		}
+
+		missingKeys = remainingKeys
+	}
+
+	for _, key := range missingKeys {
+		c.backfills.release(tickets[key])
+	}
+
+	if len(objects) == 0 && err != nil {
+		return nil, err
+	}
+
+	return objects, nil
+}
+
+// getManyWithTTL returns the objects stored in the cache layer at the given
+// index for the given keys and, unless it is the first one, their remaining
+// TTL to set them back into the upper layers. Each object is only read once,
+// along with its TTL, using a single call when the layer supports it.
+func (c *ChainCache[T]) getManyWithTTL(ctx context.Context, layer int, cache SetterCacheInterface[T], keys []any) (map[any]T, map[any]time.Duration, error) {
+	if layer == 0 {
+		objects, err := getMany[T](ctx, cache, keys)
+		return objects, nil, err
 	}
-	if len(errs) > 0 {
-		errStr := ""
-		for k, v := range errs {
-			errStr += fmt.Sprintf("error %d of %d: %v", k+1, len(errs), v.Error())
+
+	if bulkCache, ok := cache.(BulkTTLCacheInterface[T]); ok {
+		return bulkCache.GetManyWithTTL(ctx, keys)
+	}
+
+	objects := make(map[any]T, len(keys))
+	ttls := make(map[any]time.Duration, len(keys))
+	for _, key := range keys {
+		if object, ttl, err := cache.GetWithTTL(ctx, key); err == nil {
+			objects[key] = object
+			ttls[key] = ttl
 		}
-		return errors.New(errStr)
 	}
 
-	return nil
+	return objects, ttls, nil
 }
 
-// Delete removes a value from all available caches
-func (c *ChainCache[T]) Delete(ctx context.Context, key any) error {
+// SetMany sets values in the cache layers according to the write policy
+func (c *ChainCache[T]) SetMany(ctx context.Context, items map[any]T, options ...store.Option) error {
+	keys := make([]any, 0, len(items))
+	for key := range items {
+		keys = append(keys, key)
+	}
+
+	writeAsync := c.options.writePolicy == WriteAsync && len(c.caches) > 1
+
+	var err error
+	tickets := c.backfills.outdateAndAcquire(func() {
+		err = c.write(ctx, "items", func(ctx context.Context, layer int) error {
+			return c.setManyLayer(ctx, layer, items, options)
+		}, func(ctx context.Context, cache SetterCacheInterface[T]) error {
+			return deleteMany[T](ctx, cache, keys)
+		})
+	}, writeAsync, keys...)
+
+	if writeAsync {
+		for i, key := range keys {
+			c.enqueue(&chainKeyValue[T]{key: key, value: items[key], options: options, from: 1, until: len(c.caches), ticket: tickets[i]})
+		}
+	}
+
+	return err
+}
+
+// DeleteMany removes values from all available caches according to the error
+// policy
+func (c *ChainCache[T]) DeleteMany(ctx context.Context, keys []any) error {
+	var err error
+	c.backfills.outdate(func() {
+		err = c.each("delete items from", func(layer int) error {
+			err := deleteMany[T](ctx, c.caches[layer], keys)
+			if errors.Is(err, &store.NotFound{}) {
+				return nil
+			}
+			return err
+		})
+	}, keys...)
+
+	return err
+}
+
+// Exists returns whether the given key exists in any of the available caches
+func (c *ChainCache[T]) Exists(ctx context.Context, key any) (bool, error) {
+	var err error
+
 	for _, cache := range c.caches {
-		cache.Delete(ctx, key)
+		var exists bool
+		if keyCache, ok := cache.(KeyCacheInterface); ok {
+			exists, err = keyCache.Exists(ctx, key)
+		} else {
+			_, err = cache.Get(ctx, key)
+			exists = err == nil
+		}
+
+		if exists {
+			return true, nil
+		}
 	}
 
-	return nil
+	if errors.Is(err, &store.NotFound{}) {
+		return false, nil
+	}
+
+	return false, err
 }
 
-// Invalidate invalidates cache item from given options
-func (c *ChainCache[T]) Invalidate(ctx context.Context, options ...store.InvalidateOption) error {
+// Touch updates the expiration of the given key in all available caches. It
+// only fails when the key could not be touched in any of them.
+func (c *ChainCache[T]) Touch(ctx context.Context, key any, ttl time.Duration) error {
+	var err error
+	touched := false
+
+	for _, cache := range c.caches {
+		keyCache, ok := cache.(KeyCacheInterface)
+		if !ok {
+			err = store.ErrUnsupportedOperation
+			continue
+		}
+
+		if layerErr := keyCache.Touch(ctx, key, ttl); layerErr != nil {
+			err = layerErr
+			continue
+		}
+		touched = true
+	}
+
+	if touched {
+		return nil
+	}
+
+	return err
+}
+
+// Scan calls fn once for each key matching the given pattern in any of the
+// available caches, skipping the caches unable to enumerate their keys
+func (c *ChainCache[T]) Scan(ctx context.Context, pattern string, fn func(key string) error) error {
+	seen := make(map[string]struct{})
+	scanned := false
+
 	for _, cache := range c.caches {
-		cache.Invalidate(ctx, options...)
+		scanCache, ok := cache.(ScanCacheInterface)
+		if !ok {
+			continue
+		}
+
+		err := scanCache.Scan(ctx, pattern, func(key string) error {
+			if _, ok := seen[key]; ok {
+				return nil
+			}
+			seen[key] = struct{}{}
+
+			return fn(key)
+		})
+		if errors.Is(err, store.ErrUnsupportedOperation) {
+			continue
+		} else if err != nil {
+			return err
+		}
+
+		scanned = true
+	}
+
+	if !scanned {
+		return store.ErrUnsupportedOperation
 	}
 
 	return nil
 }
 
-// Clear resets all cache data
+// Delete removes a value from all available caches according to the error
+// policy. Caches not holding the value are not considered as failing.
+func (c *ChainCache[T]) Delete(ctx context.Context, key any) error {
+	var err error
+	c.backfills.outdate(func() {
+		err = c.each("delete item from", func(layer int) error {
+			err := c.caches[layer].Delete(ctx, key)
+			if errors.Is(err, &store.NotFound{}) {
+				return nil
+			}
+			return err
+		})
+	}, key)
+
+	return err
+}
+
+// Invalidate invalidates cache item from given options in all available
+// caches according to the error policy. Caches returning
+// store.ErrUnsupportedOperation, such as the ones unable to enumerate their
+// keys, are skipped and keep their values until they expire, unless no cache
+// supports the invalidation. As the caches hold the same keys, the number of
+// deleted keys reported using store.WithInvalidateDeletedCount is the largest
+// one deleted by a single cache.
+func (c *ChainCache[T]) Invalidate(ctx context.Context, options ...store.InvalidateOption) error {
+	deletedCount := store.InvalidateDeletedCount(options...)
+
+	var err error
+	c.backfills.outdateAll(func() {
+		var deleted int64
+		unsupported := 0
+
+		err = c.each("invalidate", func(layer int) error {
+			layerOptions := options
+			var layerDeleted int64
+			if deletedCount != nil {
+				layerOptions = append(options[:len(options):len(options)], store.WithInvalidateDeletedCount(&layerDeleted))
+			}
+
+			err := c.caches[layer].Invalidate(ctx, layerOptions...)
+			if errors.Is(err, store.ErrUnsupportedOperation) {
+				unsupported++
+				return nil
+			}
+
+			if layerDeleted > deleted {
+				deleted = layerDeleted
+			}
+
+			return err
+		})
+
+		if err == nil && unsupported == len(c.caches) {
+			err = store.ErrUnsupportedOperation
+		}
+
+		if deletedCount != nil {
+			*deletedCount += deleted
+		}
+	})
+
+	return err
+}
+
+// Clear resets all cache data according to the error policy
 func (c *ChainCache[T]) Clear(ctx context.Context) error {
-	for _, cache := range c.caches {
-		cache.Clear(ctx)
+	var err error
+	c.backfills.outdateAll(func() {
+		err = c.each("clear", func(layer int) error {
+			return c.caches[layer].Clear(ctx)
+		})
+	})
+
+	return err
+}
+
+// Flush waits for the values queued before it to be set in background into
+// the cache layers, or for the given context to be done
+func (c *ChainCache[T]) Flush(ctx context.Context) error {
+	flushed := make(chan struct{})
+
+	c.closeMtx.RLock()
+	if c.closed {
+		c.closeMtx.RUnlock()
+		return nil
+	}
+
+	select {
+	case c.setChannel <- &chainKeyValue[T]{flushed: flushed}:
+		c.closeMtx.RUnlock()
+	case <-ctx.Done():
+		c.closeMtx.RUnlock()
+		return ctx.Err()
 	}
 
+	select {
+	case <-flushed:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// Close sets the values queued to be set in background and stops the
+// goroutine setting them. Values read or written afterwards are set
+// synchronously into their cache layers. The cache layers are not closed,
+// see CloseAll.
+func (c *ChainCache[T]) Close() error {
+	c.closeMtx.Lock()
+	defer c.closeMtx.Unlock()
+
+	if c.closed {
+		return nil
+	}
+	c.closed = true
+
+	close(c.setChannel)
+	c.setterWg.Wait()
+
 	return nil
 }
 
+// counterCache returns the last cache layer, which keeps the counters of the
+// chained cache as it is usually the one shared between instances
+func (c *ChainCache[T]) counterCache() (CounterCacheInterface, bool) {
+	if len(c.caches) == 0 {
+		return nil, false
+	}
+
+	counterCache, ok := c.caches[len(c.caches)-1].(CounterCacheInterface)
+
+	return counterCache, ok
+}
+
+// Increment atomically increments a counter of the last cache layer, when
+// supported. Counters are not set into the other cache layers.
+func (c *ChainCache[T]) Increment(ctx context.Context, key any, delta int64, options ...store.Option) (int64, error) {
+	counterCache, ok := c.counterCache()
+	if !ok {
+		return 0, store.ErrUnsupportedOperation
+	}
+
+	return counterCache.Increment(ctx, key, delta, options...)
+}
+
+// Decrement atomically decrements a counter of the last cache layer, when
+// supported
+func (c *ChainCache[T]) Decrement(ctx context.Context, key any, delta int64, options ...store.Option) (int64, error) {
+	return c.Increment(ctx, key, -delta, options...)
+}
+
+// GetCounter obtains a counter of the last cache layer without changing it,
+// when supported
+func (c *ChainCache[T]) GetCounter(ctx context.Context, key any) (int64, error) {
+	counterCache, ok := c.counterCache()
+	if !ok {
+		return 0, store.ErrUnsupportedOperation
+	}
+
+	return counterCache.GetCounter(ctx, key)
+}
+
+// SetCounterIfNotExists initializes a counter of the last cache layer only
+// when it does not exist yet, when supported
+func (c *ChainCache[T]) SetCounterIfNotExists(ctx context.Context, key any, counter int64, options ...store.Option) error {
+	counterCache, ok := c.counterCache()
+	if !ok {
+		return store.ErrUnsupportedOperation
+	}
+
+	return counterCache.SetCounterIfNotExists(ctx, key, counter, options...)
+}
+
+// GetComponents returns the chained caches
+func (c *ChainCache[T]) GetComponents() []any {
+	components := make([]any, len(c.caches))
+	for i, cache := range c.caches {
+		components[i] = cache
+	}
+
+	return components
+}
+
+// GetKeyGenerator returns the key generator of the first chained cache, which
+// identifies the keys of the values set back into the cache layers
+func (c *ChainCache[T]) GetKeyGenerator() KeyGenerator {
+	if len(c.caches) == 0 {
+		return ChecksumKeyGenerator
+	}
+
+	return getKeyGenerator(c.caches[0])
+}
+
 // GetCaches returns all Chained caches
 func (c *ChainCache[T]) GetCaches() []SetterCacheInterface[T] {
 	return c.caches
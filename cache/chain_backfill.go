@@ -0,0 +1,247 @@
+package cache
+
+import (
+	"hash/fnv"
+	"sync"
+)
+
+// backfillStripes is the number of locks the keys of the values set in
+// background are spread over
+const backfillStripes = 256
+
+// backfillTracker orders the values set in background by a chained cache
+// against the writes and deletes of their keys, so that a value read before a
+// key is deleted is never set back into the cache layers afterwards.
+//
+// Writes never hold a lock while changing the cache layers: they mark their
+// keys as being written before and after the change, which discards the
+// values read meanwhile, and wait for the values of their keys already being
+// set back. Only the values set back hold the lock of the stripe of their key.
+//
+// Keys are only tracked while they have pending tickets or writes, so that
+// the memory used does not grow with the number of deleted keys.
+type backfillTracker struct {
+	// stripes are locked while pending values are set into the cache layers,
+	// so that writes can wait for the values of their keys being set back
+	stripes [backfillStripes]sync.Mutex
+
+	mu           sync.Mutex
+	epoch        uint64
+	clearing     int
+	versions     map[string]*backfillVersion
+	keyGenerator KeyGenerator
+}
+
+// backfillVersion is the version of a key having pending tickets or writes
+type backfillVersion struct {
+	refs    int
+	writes  int
+	version uint64
+}
+
+// backfillTicket is taken before reading a value to be set back into the
+// cache layers, and released once it has been set or discarded
+type backfillTicket struct {
+	key     string
+	epoch   uint64
+	version uint64
+}
+
+func newBackfillTracker(keyGenerator KeyGenerator) *backfillTracker {
+	return &backfillTracker{
+		versions:     make(map[string]*backfillVersion),
+		keyGenerator: keyGenerator,
+	}
+}
+
+// acquire returns a ticket holding the current version of the given key
+func (t *backfillTracker) acquire(key any) backfillTicket {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	return t.acquireLocked(generateCacheKey(key, t.keyGenerator))
+}
+
+// acquireLocked returns a ticket holding the current version of the given
+// cache key. t.mu must be held.
+func (t *backfillTracker) acquireLocked(cacheKey string) backfillTicket {
+	version := t.version(cacheKey)
+	version.refs++
+
+	return backfillTicket{
+		key:     cacheKey,
+		epoch:   t.epoch,
+		version: version.version,
+	}
+}
+
+// version returns the version of the given cache key, tracking it if needed.
+// t.mu must be held.
+func (t *backfillTracker) version(cacheKey string) *backfillVersion {
+	version, ok := t.versions[cacheKey]
+	if !ok {
+		version = &backfillVersion{}
+		t.versions[cacheKey] = version
+	}
+
+	return version
+}
+
+// untrack forgets the version of the given cache key once it has no pending
+// tickets or writes anymore. t.mu must be held.
+func (t *backfillTracker) untrack(cacheKey string, version *backfillVersion) {
+	if version.refs <= 0 && version.writes <= 0 {
+		delete(t.versions, cacheKey)
+	}
+}
+
+// release forgets the given ticket
+func (t *backfillTracker) release(ticket backfillTicket) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	version, ok := t.versions[ticket.key]
+	if !ok {
+		return
+	}
+
+	version.refs--
+	t.untrack(ticket.key, version)
+}
+
+// run calls fn unless the key of the given ticket has changed since it was
+// taken or is being changed, and releases the ticket
+func (t *backfillTracker) run(ticket backfillTicket, fn func()) {
+	stripe := t.stripe(ticket.key)
+
+	stripe.Lock()
+	if t.isCurrent(ticket) {
+		fn()
+	}
+	stripe.Unlock()
+
+	t.release(ticket)
+}
+
+// isCurrent returns whether the key of the given ticket has not changed since
+// it was taken and is not being changed
+func (t *backfillTracker) isCurrent(ticket backfillTicket) bool {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if ticket.epoch != t.epoch || t.clearing > 0 {
+		return false
+	}
+
+	version, ok := t.versions[ticket.key]
+
+	return ok && version.writes == 0 && version.version == ticket.version
+}
+
+// stripe returns the lock of the stripe of the given cache key
+func (t *backfillTracker) stripe(cacheKey string) *sync.Mutex {
+	return &t.stripes[t.stripeIndex(cacheKey)]
+}
+
+// stripeIndex returns the index of the stripe of the given cache key
+func (t *backfillTracker) stripeIndex(cacheKey string) int {
+	hash := fnv.New32a()
+	hash.Write([]byte(cacheKey))
+
+	return int(hash.Sum32() % backfillStripes)
+}
+
+// wait waits for the values being set back into the cache layers in the
+// stripes at the given indexes
+func (t *backfillTracker) wait(indexes []int) {
+	for _, index := range indexes {
+		t.stripes[index].Lock()
+		t.stripes[index].Unlock()
+	}
+}
+
+// outdate calls change, which writes or deletes the given keys in the cache
+// layers, and marks the pending tickets of the keys as outdated. No value of
+// the keys is set in background while change runs, so that a value read
+// before or during the change is never set back once it has returned.
+func (t *backfillTracker) outdate(change func(), keys ...any) {
+	t.outdateAndAcquire(change, false, keys...)
+}
+
+// outdateAndAcquire works as outdate and, when acquire is true, returns the
+// tickets of the given keys taken once they have been outdated, so that the
+// values written in background after the change are discarded by the next
+// ones
+func (t *backfillTracker) outdateAndAcquire(change func(), acquire bool, keys ...any) []backfillTicket {
+	cacheKeys := make([]string, len(keys))
+	for i, key := range keys {
+		cacheKeys[i] = generateCacheKey(key, t.keyGenerator)
+	}
+
+	indexes := make([]int, 0, len(cacheKeys))
+	seen := make(map[int]struct{}, len(cacheKeys))
+
+	t.mu.Lock()
+	for _, cacheKey := range cacheKeys {
+		version := t.version(cacheKey)
+		version.version++
+		version.writes++
+
+		index := t.stripeIndex(cacheKey)
+		if _, ok := seen[index]; !ok {
+			seen[index] = struct{}{}
+			indexes = append(indexes, index)
+		}
+	}
+	t.mu.Unlock()
+
+	t.wait(indexes)
+
+	change()
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	var tickets []backfillTicket
+	if acquire {
+		tickets = make([]backfillTicket, len(cacheKeys))
+	}
+
+	for i, cacheKey := range cacheKeys {
+		version := t.versions[cacheKey]
+		version.version++
+		version.writes--
+
+		if acquire {
+			tickets[i] = t.acquireLocked(cacheKey)
+		}
+
+		t.untrack(cacheKey, version)
+	}
+
+	return tickets
+}
+
+// outdateAll calls change, which invalidates or clears the cache layers, and
+// marks all the pending tickets as outdated. No value is set in background
+// while change runs.
+func (t *backfillTracker) outdateAll(change func()) {
+	t.mu.Lock()
+	t.epoch++
+	t.clearing++
+	t.mu.Unlock()
+
+	indexes := make([]int, backfillStripes)
+	for index := range indexes {
+		indexes[index] = index
+	}
+	t.wait(indexes)
+
+	change()
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.epoch++
+	t.clearing--
+}
@@ -0,0 +1,194 @@
+package cache
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBackfillTrackerRun(t *testing.T) {
+	// Given
+	tracker := newBackfillTracker(ChecksumKeyGenerator)
+
+	ticket := tracker.acquire("my-key")
+
+	// When
+	called := false
+	tracker.run(ticket, func() {
+		called = true
+	})
+
+	// Then
+	assert.True(t, called)
+	assert.Len(t, tracker.versions, 0)
+}
+
+func TestBackfillTrackerRunWhenOutdated(t *testing.T) {
+	// Given
+	tracker := newBackfillTracker(ChecksumKeyGenerator)
+
+	ticket := tracker.acquire("my-key")
+	otherTicket := tracker.acquire("other-key")
+
+	tracker.outdate(func() {}, "my-key")
+
+	// When
+	called := false
+	tracker.run(ticket, func() {
+		called = true
+	})
+
+	otherCalled := false
+	tracker.run(otherTicket, func() {
+		otherCalled = true
+	})
+
+	// Then
+	assert.False(t, called)
+	assert.True(t, otherCalled)
+	assert.Len(t, tracker.versions, 0)
+}
+
+func TestBackfillTrackerRunWhenAllOutdated(t *testing.T) {
+	// Given
+	tracker := newBackfillTracker(ChecksumKeyGenerator)
+
+	ticket := tracker.acquire("my-key")
+
+	tracker.outdateAll(func() {})
+
+	// When
+	called := false
+	tracker.run(ticket, func() {
+		called = true
+	})
+
+	// Then
+	assert.False(t, called)
+
+	// Tickets taken afterwards are not outdated
+	called = false
+	tracker.run(tracker.acquire("my-key"), func() {
+		called = true
+	})
+	assert.True(t, called)
+}
+
+func TestBackfillTrackerOutdateWhenNotPending(t *testing.T) {
+	// Given
+	tracker := newBackfillTracker(ChecksumKeyGenerator)
+
+	// When
+	tracker.outdate(func() {}, "my-key")
+
+	// Then
+	assert.Len(t, tracker.versions, 0)
+}
+
+func TestBackfillTrackerRunDuringOutdate(t *testing.T) {
+	// Given
+	tracker := newBackfillTracker(ChecksumKeyGenerator)
+
+	ticket := tracker.acquire("my-key")
+
+	// When
+	called := false
+	tracker.outdate(func() {
+		// The value is set back while its key is being written
+		tracker.run(ticket, func() {
+			called = true
+		})
+
+		// Tickets taken during the write are outdated once it is done
+		ticket = tracker.acquire("my-key")
+	}, "my-key")
+
+	// Then
+	assert.False(t, called)
+
+	tracker.run(ticket, func() {
+		called = true
+	})
+	assert.False(t, called)
+	assert.Len(t, tracker.versions, 0)
+}
+
+func TestBackfillTrackerOutdateWaitsForRun(t *testing.T) {
+	// Given
+	tracker := newBackfillTracker(ChecksumKeyGenerator)
+
+	running := make(chan struct{})
+	release := make(chan struct{})
+	changed := make(chan struct{})
+
+	go tracker.run(tracker.acquire("my-key"), func() {
+		close(running)
+		<-release
+	})
+	<-running
+
+	// When
+	go tracker.outdate(func() {
+		close(changed)
+	}, "my-key")
+
+	// Then
+	// The key is not changed while its value is being set back
+	select {
+	case <-changed:
+		t.Fatal("key was changed while its value was set back")
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	close(release)
+
+	select {
+	case <-changed:
+	case <-time.After(time.Second):
+		t.Fatal("key was not changed")
+	}
+}
+
+func TestBackfillTrackerOutdateConcurrently(t *testing.T) {
+	// Given
+	tracker := newBackfillTracker(ChecksumKeyGenerator)
+
+	const writes = 10
+
+	started := &sync.WaitGroup{}
+	started.Add(writes)
+
+	done := &sync.WaitGroup{}
+	done.Add(writes)
+
+	// When
+	for i := 0; i < writes; i++ {
+		go func(i int) {
+			defer done.Done()
+
+			tracker.outdate(func() {
+				// Each write waits for all of them to have started
+				started.Done()
+				started.Wait()
+			}, fmt.Sprintf("my-key-%d", i))
+		}(i)
+	}
+
+	// Then
+	// Writes of different keys do not wait for each other
+	finished := make(chan struct{})
+	go func() {
+		done.Wait()
+		close(finished)
+	}()
+
+	select {
+	case <-finished:
+	case <-time.After(time.Second):
+		t.Fatal("writes were not run concurrently")
+	}
+	assert.Len(t, tracker.versions, 0)
+}
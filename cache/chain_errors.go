@@ -0,0 +1,74 @@
+package cache
+
+import (
+	"errors"
+	"fmt"
+)
+
+// LayerError is the error returned by a cache layer of a chained cache
+type LayerError struct {
+	// Layer is the index of the cache layer in the chain
+	Layer int
+	// StoreType is the type of the store used by the cache layer
+	StoreType string
+	// Err is the error returned by the cache layer
+	Err error
+
+	action string
+}
+
+func (e *LayerError) Error() string {
+	return fmt.Sprintf("Unable to %s cache with store '%s': %v", e.action, e.StoreType, e.Err)
+}
+
+// Unwrap returns the error returned by the cache layer
+func (e *LayerError) Unwrap() error {
+	return e.Err
+}
+
+// ChainError holds the errors returned by the failing cache layers of a
+// chained cache. errors.Is and errors.As look for the target in each of them.
+type ChainError struct {
+	Errors []*LayerError
+}
+
+func (e *ChainError) Error() string {
+	errStr := ""
+	for k, v := range e.Errors {
+		errStr += fmt.Sprintf("error %d of %d: %v", k+1, len(e.Errors), v.Error())
+	}
+
+	return errStr
+}
+
+// Is returns whether the error of any failing cache layer matches the target
+func (e *ChainError) Is(target error) bool {
+	for _, err := range e.Errors {
+		if errors.Is(err, target) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// As finds the first error of the failing cache layers matching the target
+func (e *ChainError) As(target any) bool {
+	for _, err := range e.Errors {
+		if errors.As(err, target) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// newChainError returns a ChainError holding the given errors, or nil when
+// there is none
+func newChainError(errs []*LayerError) error {
+	if len(errs) == 0 {
+		return nil
+	}
+
+	return &ChainError{Errors: errs}
+}
@@ -0,0 +1,36 @@
+package cache
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/eko/gocache/v3/store"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestChainError(t *testing.T) {
+	// Given
+	err := newChainError([]*LayerError{
+		{Layer: 0, StoreType: "ristretto", Err: store.ErrUnsupportedOperation, action: "clear"},
+		{Layer: 1, StoreType: "redis", Err: errors.New("connection refused"), action: "clear"},
+	})
+
+	// When - Then
+	assert.EqualError(t, err, "error 1 of 2: Unable to clear cache with store 'ristretto': operation not supported by store"+
+		"error 2 of 2: Unable to clear cache with store 'redis': connection refused")
+	assert.ErrorIs(t, err, store.ErrUnsupportedOperation)
+	assert.False(t, errors.Is(err, store.ErrUnsupportedKeyType))
+
+	var chainErr *ChainError
+	assert.ErrorAs(t, err, &chainErr)
+	assert.Len(t, chainErr.Errors, 2)
+
+	var layerErr *LayerError
+	assert.ErrorAs(t, err, &layerErr)
+	assert.Equal(t, "ristretto", layerErr.StoreType)
+}
+
+func TestChainErrorWhenEmpty(t *testing.T) {
+	// When - Then
+	assert.Nil(t, newChainError(nil))
+}
@@ -0,0 +1,165 @@
+package cache
+
+import (
+	"time"
+
+	"github.com/eko/gocache/v3/store"
+)
+
+// WritePolicy defines how a chained cache writes values into its cache layers
+type WritePolicy int
+
+const (
+	// WriteThrough writes values into all the cache layers synchronously. It
+	// is the default write policy.
+	WriteThrough WritePolicy = iota
+	// WriteLastOnly only writes values into the last cache layer, the upper
+	// ones being backfilled when the values are read
+	WriteLastOnly
+	// WriteAround writes values into the last cache layer and deletes them
+	// from the upper ones, so that stale values are not read from them
+	WriteAround
+	// WriteAsync writes values into the first cache layer synchronously and
+	// into the lower ones in background
+	WriteAsync
+)
+
+// BackfillPolicy defines how a chained cache sets the values found in a cache
+// layer back into the upper ones
+type BackfillPolicy int
+
+const (
+	// BackfillAsync sets values back into the upper cache layers in background.
+	// It is the default backfill policy.
+	BackfillAsync BackfillPolicy = iota
+	// BackfillSync sets values back into the upper cache layers before
+	// returning them
+	BackfillSync
+)
+
+// ErrorPolicy defines how a chained cache handles the errors returned by its
+// cache layers
+type ErrorPolicy int
+
+const (
+	// BestEffort applies operations to all the cache layers and returns the
+	// errors of the failing ones. It is the default error policy.
+	BestEffort ErrorPolicy = iota
+	// FailFast stops operations at the first failing cache layer
+	FailFast
+)
+
+// LayerOptionTransformer returns the options used to set the given object
+// into a cache layer from the options given to the chained cache, or computed
+// when backfilling the layer
+type LayerOptionTransformer func(object any, options []store.Option) []store.Option
+
+// MaxTTLTransformer caps the expiration of the values set into a cache layer
+// to the given TTL, including values without expiration. The store default
+// expiration is used when it is shorter and no expiration is given.
+func MaxTTLTransformer(ttl time.Duration) LayerOptionTransformer {
+	return func(object any, options []store.Option) []store.Option {
+		return append(options, store.WithOverride(store.WithMaxExpiration(ttl)))
+	}
+}
+
+// FixedTTLTransformer sets the values into a cache layer with the given TTL,
+// regardless of the given expiration
+func FixedTTLTransformer(ttl time.Duration) LayerOptionTransformer {
+	return func(object any, options []store.Option) []store.Option {
+		return append(options, store.WithOverride(store.WithExpiration(ttl)))
+	}
+}
+
+// CostTransformer sets the values into a cache layer with the cost returned
+// by the given function, as used by Ristretto. The other store default
+// options are kept when no option is given.
+func CostTransformer(costFunc func(object any) int64) LayerOptionTransformer {
+	return func(object any, options []store.Option) []store.Option {
+		return append(options, store.WithOverride(store.WithCost(costFunc(object))))
+	}
+}
+
+// TagsTransformer sets the values into a cache layer with the tags returned
+// by the given function from the given tags
+func TagsTransformer(tagsFunc func(tags []string) []string) LayerOptionTransformer {
+	return func(object any, options []store.Option) []store.Option {
+		return append(options, store.WithOverride(store.WithTagsFunc(tagsFunc)))
+	}
+}
+
+// ChainOption represents a chained cache option function.
+type ChainOption func(o *chainOptions)
+
+type chainOptions struct {
+	writePolicy    WritePolicy
+	backfillPolicy BackfillPolicy
+	errorPolicy    ErrorPolicy
+
+	layerTransformers map[int][]LayerOptionTransformer
+}
+
+// layerOptions returns the options used to set the given object into the
+// cache layer at the given index
+func (o *chainOptions) layerOptions(layer int, object any, options []store.Option) []store.Option {
+	transformers := o.layerTransformers[layer]
+	if len(transformers) == 0 {
+		return options
+	}
+
+	// Copy the options so that transformers do not append to the slice given
+	// to the other layers
+	options = append([]store.Option{}, options...)
+	for _, transformer := range transformers {
+		options = transformer(object, options)
+	}
+
+	return options
+}
+
+func applyChainOptions(opts ...ChainOption) *chainOptions {
+	o := &chainOptions{}
+
+	for _, opt := range opts {
+		opt(o)
+	}
+
+	return o
+}
+
+// WithWritePolicy allows to specify how values are written into the cache
+// layers. WriteThrough is used by default.
+func WithWritePolicy(writePolicy WritePolicy) ChainOption {
+	return func(o *chainOptions) {
+		o.writePolicy = writePolicy
+	}
+}
+
+// WithBackfillPolicy allows to specify whether the values found in a cache
+// layer are set back into the upper ones in background or before being
+// returned. BackfillAsync is used by default.
+func WithBackfillPolicy(backfillPolicy BackfillPolicy) ChainOption {
+	return func(o *chainOptions) {
+		o.backfillPolicy = backfillPolicy
+	}
+}
+
+// WithErrorPolicy allows to specify whether operations are applied to the
+// remaining cache layers when one of them fails. BestEffort is used by default.
+func WithErrorPolicy(errorPolicy ErrorPolicy) ChainOption {
+	return func(o *chainOptions) {
+		o.errorPolicy = errorPolicy
+	}
+}
+
+// WithLayerOptions allows to transform the options used to set values into the
+// cache layer at the given index, both when setting and when backfilling them.
+// Transformers are applied in the given order.
+func WithLayerOptions(layer int, transformers ...LayerOptionTransformer) ChainOption {
+	return func(o *chainOptions) {
+		if o.layerTransformers == nil {
+			o.layerTransformers = make(map[int][]LayerOptionTransformer)
+		}
+		o.layerTransformers[layer] = append(o.layerTransformers[layer], transformers...)
+	}
+}
@@ -7,6 +7,8 @@ import (
 	"testing"
 	"time"
 
+	"github.com/allegro/bigcache/v3"
+	"github.com/coocood/freecache"
 	"github.com/eko/gocache/v3/store"
 	mocksCache "github.com/eko/gocache/v3/test/mocks/cache"
 	mocksCodec "github.com/eko/gocache/v3/test/mocks/codec"
@@ -50,6 +52,25 @@ func TestChainGetCaches(t *testing.T) {
 	assert.Equal(t, cache2, caches[1])
 }
 
+func TestChainGetKeyGenerator(t *testing.T) {
+	// Given
+	type bookKey struct {
+		ID int
+	}
+
+	cache1 := New[any](store.NewFreecache(freecache.NewCache(1024*1024)), WithKeyGenerator(ReadableKeyGenerator))
+	cache2 := New[any](store.NewFreecache(freecache.NewCache(1024 * 1024)))
+
+	cache := NewChain[any](cache1, cache2)
+
+	// When
+	ticket := cache.backfills.acquire(bookKey{ID: 42})
+
+	// Then
+	assert.Equal(t, "bookKey:ID=42", cache.GetKeyGenerator()(bookKey{ID: 42}))
+	assert.Equal(t, "bookKey:ID=42", ticket.key)
+}
+
 func TestChainGetWhenAvailableInFirstCache(t *testing.T) {
 	// Given
 	ctrl := gomock.NewController(t)
@@ -147,26 +168,12 @@ func TestChainGetWhenNotAvailableInAnyCache(t *testing.T) {
 	ctx := context.Background()
 
 	// Cache 1
-	store1 := mocksStore.NewMockStoreInterface(ctrl)
-	store1.EXPECT().GetType().Return("store1")
-
-	codec1 := mocksCodec.NewMockCodecInterface(ctrl)
-	codec1.EXPECT().GetStore().Return(store1)
-
 	cache1 := mocksCache.NewMockSetterCacheInterface[any](ctrl)
-	cache1.EXPECT().GetCodec().Return(codec1)
 	cache1.EXPECT().GetWithTTL(ctx, "my-key").Return(nil, 0*time.Second,
 		errors.New("unable to find in cache 1"))
 
 	// Cache 2
-	store2 := mocksStore.NewMockStoreInterface(ctrl)
-	store2.EXPECT().GetType().Return("store2")
-
-	codec2 := mocksCodec.NewMockCodecInterface(ctrl)
-	codec2.EXPECT().GetStore().Return(store2)
-
 	cache2 := mocksCache.NewMockSetterCacheInterface[any](ctrl)
-	cache2.EXPECT().GetCodec().Return(codec2)
 	cache2.EXPECT().GetWithTTL(ctx, "my-key").Return(nil, 0*time.Second,
 		errors.New("unable to find in cache 2"))
 
@@ -183,6 +190,122 @@ func TestChainGetWhenNotAvailableInAnyCache(t *testing.T) {
 	assert.Equal(t, nil, value)
 }
 
+func TestChainGetManyWhenAvailableInDifferentCaches(t *testing.T) {
+	// Given
+	ctrl := gomock.NewController(t)
+
+	ctx := context.Background()
+
+	// Cache 1
+	store1 := mocksStore.NewMockStoreInterface(ctrl)
+	store1.EXPECT().GetType().AnyTimes().Return("store1")
+
+	codec1 := mocksCodec.NewMockCodecInterface(ctrl)
+	codec1.EXPECT().GetStore().AnyTimes().Return(store1)
+
+	cache1 := mocksCache.NewMockSetterCacheInterface[any](ctrl)
+	cache1.EXPECT().GetCodec().AnyTimes().Return(codec1)
+	cache1.EXPECT().Get(ctx, "key-1").Return("value 1", nil)
+	cache1.EXPECT().Get(ctx, "key-2").Return(nil, errors.New("unable to find in cache 1"))
+	cache1.EXPECT().Get(ctx, "key-3").Return(nil, errors.New("unable to find in cache 1"))
+	cache1.EXPECT().Set(gomock.Any(), "key-2", "value 2", store.OptionsMatcher{
+		Expiration: 5 * time.Second,
+	}).Return(nil)
+
+	// Cache 2
+	store2 := mocksStore.NewMockStoreInterface(ctrl)
+	store2.EXPECT().GetType().AnyTimes().Return("store2")
+
+	codec2 := mocksCodec.NewMockCodecInterface(ctrl)
+	codec2.EXPECT().GetStore().AnyTimes().Return(store2)
+
+	cache2 := mocksCache.NewMockSetterCacheInterface[any](ctrl)
+	cache2.EXPECT().GetCodec().AnyTimes().Return(codec2)
+	cache2.EXPECT().GetWithTTL(ctx, "key-2").Return("value 2", 5*time.Second, nil)
+	cache2.EXPECT().GetWithTTL(ctx, "key-3").Return(nil, 0*time.Second, errors.New("unable to find in cache 2"))
+
+	cache := NewChainWithOptions[any]([]SetterCacheInterface[any]{cache1, cache2},
+		WithBackfillPolicy(BackfillSync),
+	)
+
+	// When
+	values, err := cache.GetMany(ctx, []any{"key-1", "key-2", "key-3"})
+
+	// Then
+	assert.Nil(t, err)
+	assert.Equal(t, map[any]any{"key-1": "value 1", "key-2": "value 2"}, values)
+}
+
+func TestChainGetManyBackfillsRemainingTTL(t *testing.T) {
+	// Given
+	ctx := context.Background()
+
+	cache1 := New[any](store.NewFreecache(freecache.NewCache(1024 * 1024)))
+	cache2 := New[any](store.NewFreecache(freecache.NewCache(1024 * 1024)))
+
+	err := cache2.Set(ctx, "my-key", []byte("my-value"), store.WithExpiration(30*time.Second))
+	assert.Nil(t, err)
+
+	cache := NewChainWithOptions[any]([]SetterCacheInterface[any]{cache1, cache2},
+		WithBackfillPolicy(BackfillSync),
+	)
+
+	// When
+	values, err := cache.GetMany(ctx, []any{"my-key"})
+
+	// Then
+	assert.Nil(t, err)
+	assert.Equal(t, map[any]any{"my-key": []byte("my-value")}, values)
+
+	_, ttl, err := cache1.GetWithTTL(ctx, "my-key")
+	assert.Nil(t, err)
+	assert.Greater(t, ttl, 20*time.Second)
+	assert.LessOrEqual(t, ttl, 30*time.Second)
+}
+
+func TestChainGetManyWhenBulkTTLLayer(t *testing.T) {
+	// Given
+	ctrl := gomock.NewController(t)
+
+	ctx := context.Background()
+
+	// Cache 1
+	cache1 := mocksCache.NewMockSetterCacheInterface[any](ctrl)
+	cache1.EXPECT().Get(ctx, "key-1").Return(nil, errors.New("unable to find in cache 1"))
+	cache1.EXPECT().Get(ctx, "key-2").Return(nil, errors.New("unable to find in cache 1"))
+	cache1.EXPECT().Set(gomock.Any(), "key-1", "value 1", store.OptionsMatcher{
+		Expiration: 5 * time.Second,
+	}).Return(nil)
+
+	// Cache 2 returns the values along with their TTL in a single call,
+	// without reading them again
+	bulkCache := mocksCache.NewMockBulkTTLCacheInterface[any](ctrl)
+	bulkCache.EXPECT().GetManyWithTTL(ctx, []any{"key-1", "key-2"}).Return(
+		map[any]any{"key-1": "value 1"},
+		map[any]time.Duration{"key-1": 5 * time.Second},
+		nil,
+	)
+
+	cache2 := &struct {
+		*mocksCache.MockSetterCacheInterface[any]
+		*mocksCache.MockBulkTTLCacheInterface[any]
+	}{
+		mocksCache.NewMockSetterCacheInterface[any](ctrl),
+		bulkCache,
+	}
+
+	cache := NewChainWithOptions[any]([]SetterCacheInterface[any]{cache1, cache2},
+		WithBackfillPolicy(BackfillSync),
+	)
+
+	// When
+	values, err := cache.GetMany(ctx, []any{"key-1", "key-2"})
+
+	// Then
+	assert.Nil(t, err)
+	assert.Equal(t, map[any]any{"key-1": "value 1"}, values)
+}
+
 func TestChainSet(t *testing.T) {
 	// Given
 	ctrl := gomock.NewController(t)
@@ -251,53 +374,280 @@ func TestChainSetWhenErrorOnSetting(t *testing.T) {
 	assert.Equal(t, fmt.Sprintf("error 1 of 1: Unable to set item into cache with store 'store1': %s", expectedErr.Error()), err.Error())
 }
 
-func TestChainDelete(t *testing.T) {
+func TestChainGetWithBackfillSync(t *testing.T) {
+	// Given
+	ctrl := gomock.NewController(t)
+
+	ctx := context.Background()
+
+	// Cache 1
+	cache1 := mocksCache.NewMockSetterCacheInterface[any](ctrl)
+	cache1.EXPECT().GetWithTTL(ctx, "my-key").Return(nil, 0*time.Second,
+		errors.New("unable to find in cache 1"))
+	cache1.EXPECT().Set(ctx, "my-key", "my-value", store.OptionsMatcher{
+		Expiration: 5 * time.Second,
+	}).Return(nil)
+
+	// Cache 2
+	cache2 := mocksCache.NewMockSetterCacheInterface[any](ctrl)
+	cache2.EXPECT().GetWithTTL(ctx, "my-key").Return("my-value", 5*time.Second, nil)
+
+	cache := NewChainWithOptions[any]([]SetterCacheInterface[any]{cache1, cache2},
+		WithBackfillPolicy(BackfillSync),
+	)
+
+	// When
+	value, err := cache.Get(ctx, "my-key")
+
+	// Then
+	assert.Nil(t, err)
+	assert.Equal(t, "my-value", value)
+}
+
+func TestChainGetWithLayerMaxTTL(t *testing.T) {
+	// Given
+	ctrl := gomock.NewController(t)
+
+	ctx := context.Background()
+
+	// Cache 1
+	cache1 := mocksCache.NewMockSetterCacheInterface[any](ctrl)
+	cache1.EXPECT().GetWithTTL(ctx, "my-key").Return(nil, 0*time.Second,
+		errors.New("unable to find in cache 1"))
+	cache1.EXPECT().Set(ctx, "my-key", "my-value", store.OptionsMatcher{
+		Expiration: 30 * time.Second,
+	}).Return(nil)
+
+	// Cache 2
+	cache2 := mocksCache.NewMockSetterCacheInterface[any](ctrl)
+	cache2.EXPECT().GetWithTTL(ctx, "my-key").Return("my-value", 10*time.Minute, nil)
+
+	cache := NewChainWithOptions[any]([]SetterCacheInterface[any]{cache1, cache2},
+		WithBackfillPolicy(BackfillSync),
+		WithLayerOptions(0, MaxTTLTransformer(30*time.Second)),
+	)
+
+	// When
+	value, err := cache.Get(ctx, "my-key")
+
+	// Then
+	assert.Nil(t, err)
+	assert.Equal(t, "my-value", value)
+}
+
+func TestChainSetWithLayerOptions(t *testing.T) {
+	// Given
+	ctrl := gomock.NewController(t)
+
+	ctx := context.Background()
+
+	// Cache 1
+	cache1 := mocksCache.NewMockSetterCacheInterface[any](ctrl)
+	cache1.EXPECT().Set(ctx, "my-key", "my-value", store.OptionsMatcher{
+		Cost:       8,
+		Expiration: 10 * time.Second,
+		Tags:       []string{"tag1", "l1"},
+	}).Return(nil)
+
+	// Cache 2
+	cache2 := mocksCache.NewMockSetterCacheInterface[any](ctrl)
+	cache2.EXPECT().Set(ctx, "my-key", "my-value", store.OptionsMatcher{
+		Expiration: 5 * time.Minute,
+		Tags:       []string{"tag1"},
+	}).Return(nil)
+
+	cache := NewChainWithOptions[any]([]SetterCacheInterface[any]{cache1, cache2},
+		WithLayerOptions(0,
+			FixedTTLTransformer(10*time.Second),
+			CostTransformer(func(object any) int64 {
+				return int64(len(object.(string)))
+			}),
+			TagsTransformer(func(tags []string) []string {
+				return append(tags, "l1")
+			}),
+		),
+	)
+
+	// When
+	err := cache.Set(ctx, "my-key", "my-value",
+		store.WithExpiration(5*time.Minute),
+		store.WithTags([]string{"tag1"}),
+	)
+
+	// Then
+	assert.Nil(t, err)
+}
+
+func TestChainGetWhenDeletedBeforeBackfill(t *testing.T) {
 	// Given
 	ctrl := gomock.NewController(t)
 
 	ctx := context.Background()
 
+	var cache *ChainCache[any]
+
 	// Cache 1
 	cache1 := mocksCache.NewMockSetterCacheInterface[any](ctrl)
+	cache1.EXPECT().GetWithTTL(ctx, "my-key").Return(nil, 0*time.Second,
+		errors.New("unable to find in cache 1"))
 	cache1.EXPECT().Delete(ctx, "my-key").Return(nil)
 
 	// Cache 2
 	cache2 := mocksCache.NewMockSetterCacheInterface[any](ctrl)
+	cache2.EXPECT().GetWithTTL(ctx, "my-key").DoAndReturn(func(ctx context.Context, key any) (any, time.Duration, error) {
+		// The key is deleted while its value is being read
+		assert.Nil(t, cache.Delete(ctx, key))
+		return "my-value", 5 * time.Second, nil
+	})
 	cache2.EXPECT().Delete(ctx, "my-key").Return(nil)
 
-	cache := NewChain[any](cache1, cache2)
+	cache = NewChainWithOptions[any]([]SetterCacheInterface[any]{cache1, cache2},
+		WithBackfillPolicy(BackfillSync),
+	)
 
 	// When
-	err := cache.Delete(ctx, "my-key")
+	value, err := cache.Get(ctx, "my-key")
 
 	// Then
 	assert.Nil(t, err)
+	assert.Equal(t, "my-value", value)
 }
 
-func TestChainDeleteWhenError(t *testing.T) {
+func TestChainGetWhenDeletedBeforeAsyncBackfill(t *testing.T) {
 	// Given
 	ctrl := gomock.NewController(t)
 
 	ctx := context.Background()
 
+	var cache *ChainCache[any]
+
+	written := make(chan struct{})
+
 	// Cache 1
 	cache1 := mocksCache.NewMockSetterCacheInterface[any](ctrl)
-	cache1.EXPECT().Delete(ctx, "my-key").Return(errors.New("an error has occurred while deleting key"))
+	cache1.EXPECT().GetWithTTL(ctx, gomock.Any()).Return(nil, 0*time.Second,
+		errors.New("unable to find in cache 1")).Times(2)
+	cache1.EXPECT().Delete(ctx, "my-key").Return(nil)
+	cache1.EXPECT().Set(gomock.Any(), "other-key", "other-value", gomock.Any()).DoAndReturn(func(_, _, _ any, _ ...store.Option) error {
+		close(written)
+		return nil
+	})
 
 	// Cache 2
 	cache2 := mocksCache.NewMockSetterCacheInterface[any](ctrl)
+	cache2.EXPECT().GetWithTTL(ctx, "my-key").DoAndReturn(func(ctx context.Context, key any) (any, time.Duration, error) {
+		// The key is deleted while its value is being read
+		assert.Nil(t, cache.Delete(ctx, key))
+		return "my-value", 5 * time.Second, nil
+	})
+	cache2.EXPECT().GetWithTTL(ctx, "other-key").Return("other-value", 5*time.Second, nil)
 	cache2.EXPECT().Delete(ctx, "my-key").Return(nil)
 
-	cache := NewChain[any](cache1, cache2)
+	cache = NewChain[any](cache1, cache2)
+
+	// When
+	_, err := cache.Get(ctx, "my-key")
+	assert.Nil(t, err)
+
+	_, err = cache.Get(ctx, "other-key")
+	assert.Nil(t, err)
+
+	// Then
+	// Backfills are handled in order, so that "my-key" has been discarded
+	// once "other-key" is written
+	select {
+	case <-written:
+	case <-time.After(time.Second):
+		t.Fatal("value was not written into the first cache")
+	}
+}
+
+func TestChainGetDuringSlowDelete(t *testing.T) {
+	// Given
+	ctrl := gomock.NewController(t)
+
+	ctx := context.Background()
+
+	var cache *ChainCache[any]
+
+	read := make(chan struct{})
+	got := make(chan struct{})
+
+	// Cache 1
+	cache1 := mocksCache.NewMockSetterCacheInterface[any](ctrl)
+	cache1.EXPECT().Delete(ctx, "my-key").Return(nil)
+	cache1.EXPECT().GetWithTTL(ctx, "my-key").Return(nil, 0*time.Second,
+		errors.New("unable to find in cache 1"))
+
+	// Cache 2
+	cache2 := mocksCache.NewMockSetterCacheInterface[any](ctrl)
+	cache2.EXPECT().GetWithTTL(ctx, "my-key").DoAndReturn(func(ctx context.Context, key any) (any, time.Duration, error) {
+		close(read)
+		return "my-value", 5 * time.Second, nil
+	})
+	cache2.EXPECT().Delete(ctx, "my-key").DoAndReturn(func(ctx context.Context, key any) error {
+		// The key is read from the second cache, once deleted from the first
+		// one and before being deleted from the second one
+		go func() {
+			defer close(got)
+			_, err := cache.Get(ctx, key)
+			assert.Nil(t, err)
+		}()
+		<-read
+
+		return nil
+	})
+
+	cache = NewChainWithOptions[any]([]SetterCacheInterface[any]{cache1, cache2},
+		WithBackfillPolicy(BackfillSync),
+	)
 
 	// When
 	err := cache.Delete(ctx, "my-key")
 
 	// Then
 	assert.Nil(t, err)
+
+	// The value read during the delete is not set back into the first cache
+	select {
+	case <-got:
+	case <-time.After(time.Second):
+		t.Fatal("value was not returned")
+	}
 }
 
-func TestChainInvalidate(t *testing.T) {
+func TestChainSetWithLayerOptionsAndStoreDefaults(t *testing.T) {
+	// Given
+	ctx := context.Background()
+
+	costFunc := func(object any) int64 {
+		return int64(len(object.([]byte)))
+	}
+
+	// The default expiration of the caches is shorter than the max TTL
+	cache1 := New[any](store.NewFreecache(freecache.NewCache(1024*1024), store.WithExpiration(10*time.Second)))
+	cache2 := New[any](store.NewFreecache(freecache.NewCache(1024*1024), store.WithExpiration(10*time.Second)))
+
+	cache := NewChainWithOptions[any]([]SetterCacheInterface[any]{cache1, cache2},
+		WithLayerOptions(0, MaxTTLTransformer(30*time.Second), CostTransformer(costFunc)),
+		WithLayerOptions(1, CostTransformer(costFunc)),
+	)
+
+	// When
+	err := cache.Set(ctx, "my-key", []byte("my-value"))
+
+	// Then
+	assert.Nil(t, err)
+
+	for _, layer := range []SetterCacheInterface[any]{cache1, cache2} {
+		_, ttl, err := layer.GetWithTTL(ctx, "my-key")
+		assert.Nil(t, err)
+		assert.Greater(t, ttl, 5*time.Second)
+		assert.LessOrEqual(t, ttl, 10*time.Second)
+	}
+}
+
+func TestChainSetWithWriteLastOnly(t *testing.T) {
 	// Given
 	ctrl := gomock.NewController(t)
 
@@ -305,22 +655,23 @@ func TestChainInvalidate(t *testing.T) {
 
 	// Cache 1
 	cache1 := mocksCache.NewMockSetterCacheInterface[any](ctrl)
-	cache1.EXPECT().Invalidate(ctx).Return(nil)
 
 	// Cache 2
 	cache2 := mocksCache.NewMockSetterCacheInterface[any](ctrl)
-	cache2.EXPECT().Invalidate(ctx).Return(nil)
+	cache2.EXPECT().Set(ctx, "my-key", "my-value").Return(nil)
 
-	cache := NewChain[any](cache1, cache2)
+	cache := NewChainWithOptions[any]([]SetterCacheInterface[any]{cache1, cache2},
+		WithWritePolicy(WriteLastOnly),
+	)
 
 	// When
-	err := cache.Invalidate(ctx)
+	err := cache.Set(ctx, "my-key", "my-value")
 
 	// Then
 	assert.Nil(t, err)
 }
 
-func TestChainInvalidateWhenError(t *testing.T) {
+func TestChainSetWithWriteAround(t *testing.T) {
 	// Given
 	ctrl := gomock.NewController(t)
 
@@ -328,65 +679,748 @@ func TestChainInvalidateWhenError(t *testing.T) {
 
 	// Cache 1
 	cache1 := mocksCache.NewMockSetterCacheInterface[any](ctrl)
-	cache1.EXPECT().Invalidate(ctx).Return(errors.New("an unexpected error has occurred while invalidation data"))
+	cache1.EXPECT().Delete(ctx, "my-key").Return(nil)
 
 	// Cache 2
 	cache2 := mocksCache.NewMockSetterCacheInterface[any](ctrl)
-	cache2.EXPECT().Invalidate(ctx).Return(nil)
+	cache2.EXPECT().Set(ctx, "my-key", "my-value").Return(nil)
 
-	cache := NewChain[any](cache1, cache2)
+	cache := NewChainWithOptions[any]([]SetterCacheInterface[any]{cache1, cache2},
+		WithWritePolicy(WriteAround),
+	)
 
 	// When
-	err := cache.Invalidate(ctx)
+	err := cache.Set(ctx, "my-key", "my-value")
 
 	// Then
 	assert.Nil(t, err)
 }
 
-func TestChainClear(t *testing.T) {
+func TestChainSetWithWriteAroundWhenAbsentFromStores(t *testing.T) {
+	// Given
+	ctx := context.Background()
+
+	bigcacheClient, err := bigcache.NewBigCache(bigcache.DefaultConfig(time.Minute))
+	assert.Nil(t, err)
+
+	cache1 := New[any](store.NewFreecache(freecache.NewCache(1024 * 1024)))
+	cache2 := New[any](store.NewBigcache(bigcacheClient))
+
+	cache := NewChainWithOptions[any]([]SetterCacheInterface[any]{cache1, cache2},
+		WithWritePolicy(WriteAround),
+	)
+
+	// When
+	err = cache.Set(ctx, "my-key", []byte("my-value"))
+
+	// Then
+	assert.Nil(t, err)
+	assert.Nil(t, cache.SetMany(ctx, map[any]any{"key1": []byte("value1")}))
+
+	value, err := cache2.Get(ctx, "my-key")
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("my-value"), value)
+
+	_, err = cache1.Get(ctx, "my-key")
+	assert.ErrorIs(t, err, &store.NotFound{})
+}
+
+func TestChainSetWithWriteAroundWhenInvalidationFails(t *testing.T) {
 	// Given
 	ctrl := gomock.NewController(t)
 
 	ctx := context.Background()
 
 	// Cache 1
+	store1 := mocksStore.NewMockStoreInterface(ctrl)
+	store1.EXPECT().GetType().Return("store1")
+
+	codec1 := mocksCodec.NewMockCodecInterface(ctrl)
+	codec1.EXPECT().GetStore().Return(store1)
+
 	cache1 := mocksCache.NewMockSetterCacheInterface[any](ctrl)
-	cache1.EXPECT().Clear(ctx).Return(nil)
+	cache1.EXPECT().GetCodec().Return(codec1)
+	cache1.EXPECT().Delete(ctx, "my-key").Return(errors.New("connection refused"))
 
 	// Cache 2
 	cache2 := mocksCache.NewMockSetterCacheInterface[any](ctrl)
-	cache2.EXPECT().Clear(ctx).Return(nil)
+	cache2.EXPECT().Set(ctx, "my-key", "my-value").Return(nil)
 
-	cache := NewChain[any](cache1, cache2)
+	cache := NewChainWithOptions[any]([]SetterCacheInterface[any]{cache1, cache2},
+		WithWritePolicy(WriteAround),
+	)
 
 	// When
-	err := cache.Clear(ctx)
+	err := cache.Set(ctx, "my-key", "my-value")
 
 	// Then
-	assert.Nil(t, err)
+	assert.EqualError(t, err, "error 1 of 1: Unable to invalidate item in cache with store 'store1': connection refused")
 }
 
-func TestChainClearWhenError(t *testing.T) {
+func TestChainSetWithWriteAsync(t *testing.T) {
 	// Given
 	ctrl := gomock.NewController(t)
 
 	ctx := context.Background()
 
+	written := make(chan struct{})
+
 	// Cache 1
 	cache1 := mocksCache.NewMockSetterCacheInterface[any](ctrl)
-	cache1.EXPECT().Clear(ctx).Return(errors.New("an unexpected error has occurred while invalidation data"))
+	cache1.EXPECT().Set(ctx, "my-key", "my-value").Return(nil)
 
 	// Cache 2
 	cache2 := mocksCache.NewMockSetterCacheInterface[any](ctrl)
-	cache2.EXPECT().Clear(ctx).Return(nil)
+	cache2.EXPECT().Set(gomock.Any(), "my-key", "my-value").DoAndReturn(func(_, _, _ any, _ ...store.Option) error {
+		close(written)
+		return nil
+	})
 
-	cache := NewChain[any](cache1, cache2)
+	cache := NewChainWithOptions[any]([]SetterCacheInterface[any]{cache1, cache2},
+		WithWritePolicy(WriteAsync),
+	)
 
 	// When
-	err := cache.Clear(ctx)
+	err := cache.Set(ctx, "my-key", "my-value")
 
 	// Then
 	assert.Nil(t, err)
+
+	select {
+	case <-written:
+	case <-time.After(time.Second):
+		t.Fatal("value was not written into the second cache")
+	}
+}
+
+func TestChainSetManyWithWriteLastOnly(t *testing.T) {
+	// Given
+	ctrl := gomock.NewController(t)
+
+	ctx := context.Background()
+
+	// Cache 1
+	cache1 := mocksCache.NewMockSetterCacheInterface[any](ctrl)
+
+	// Cache 2
+	cache2 := mocksCache.NewMockSetterCacheInterface[any](ctrl)
+	cache2.EXPECT().Set(ctx, "key-1", "value 1").Return(nil)
+	cache2.EXPECT().Set(ctx, "key-2", "value 2").Return(nil)
+
+	cache := NewChainWithOptions[any]([]SetterCacheInterface[any]{cache1, cache2},
+		WithWritePolicy(WriteLastOnly),
+	)
+
+	// When
+	err := cache.SetMany(ctx, map[any]any{"key-1": "value 1", "key-2": "value 2"})
+
+	// Then
+	assert.Nil(t, err)
+}
+
+func TestChainExistsWhenAvailableInSecondCache(t *testing.T) {
+	// Given
+	ctrl := gomock.NewController(t)
+
+	ctx := context.Background()
+
+	// Cache 1
+	cache1 := mocksCache.NewMockSetterCacheInterface[any](ctrl)
+	cache1.EXPECT().Get(ctx, "my-key").Return(nil, store.NotFoundWithCause(errors.New("value not found")))
+
+	// Cache 2
+	existsStore := mocksStore.NewMockExistsStoreInterface(ctrl)
+	existsStore.EXPECT().Exists(ctx, "my-key").Return(true, nil)
+
+	cache2 := New[any](&struct {
+		*mocksStore.MockStoreInterface
+		*mocksStore.MockExistsStoreInterface
+	}{
+		mocksStore.NewMockStoreInterface(ctrl),
+		existsStore,
+	})
+
+	cache := NewChain[any](cache1, cache2)
+
+	// When
+	exists, err := cache.Exists(ctx, "my-key")
+
+	// Then
+	assert.Nil(t, err)
+	assert.True(t, exists)
+}
+
+func TestChainTouchWhenSupportedByOneCache(t *testing.T) {
+	// Given
+	ctrl := gomock.NewController(t)
+
+	ctx := context.Background()
+
+	// Cache 1
+	cache1 := mocksCache.NewMockSetterCacheInterface[any](ctrl)
+
+	// Cache 2
+	touchStore := mocksStore.NewMockTouchStoreInterface(ctrl)
+	touchStore.EXPECT().Touch(ctx, "my-key", 10*time.Second).Return(nil)
+
+	cache2 := New[any](&struct {
+		*mocksStore.MockStoreInterface
+		*mocksStore.MockTouchStoreInterface
+	}{
+		mocksStore.NewMockStoreInterface(ctrl),
+		touchStore,
+	})
+
+	cache := NewChain[any](cache1, cache2)
+
+	// When
+	err := cache.Touch(ctx, "my-key", 10*time.Second)
+
+	// Then
+	assert.Nil(t, err)
+}
+
+func TestChainTouchWhenNotFound(t *testing.T) {
+	// Given
+	ctrl := gomock.NewController(t)
+
+	ctx := context.Background()
+
+	touchStore := mocksStore.NewMockTouchStoreInterface(ctrl)
+	touchStore.EXPECT().Touch(ctx, "my-key", 10*time.Second).Return(store.NotFoundWithCause(errors.New("value not found")))
+
+	cache1 := New[any](&struct {
+		*mocksStore.MockStoreInterface
+		*mocksStore.MockTouchStoreInterface
+	}{
+		mocksStore.NewMockStoreInterface(ctrl),
+		touchStore,
+	})
+
+	cache := NewChain[any](cache1)
+
+	// When
+	err := cache.Touch(ctx, "my-key", 10*time.Second)
+
+	// Then
+	assert.ErrorIs(t, err, &store.NotFound{})
+}
+
+func TestChainScan(t *testing.T) {
+	// Given
+	ctrl := gomock.NewController(t)
+
+	ctx := context.Background()
+
+	scanStore := func(keys ...string) *mocksStore.MockScanStoreInterface {
+		scanStore := mocksStore.NewMockScanStoreInterface(ctrl)
+		scanStore.EXPECT().Scan(ctx, "my-*", gomock.Any()).DoAndReturn(
+			func(_ context.Context, _ string, fn func(key string) error) error {
+				for _, key := range keys {
+					if err := fn(key); err != nil {
+						return err
+					}
+				}
+				return nil
+			},
+		)
+		return scanStore
+	}
+
+	newCache := func(scanStore *mocksStore.MockScanStoreInterface) *Cache[any] {
+		return New[any](&struct {
+			*mocksStore.MockStoreInterface
+			*mocksStore.MockScanStoreInterface
+		}{
+			mocksStore.NewMockStoreInterface(ctrl),
+			scanStore,
+		})
+	}
+
+	// Cache 1 and 2 share a key while cache 3 is unable to enumerate its keys
+	cache1 := newCache(scanStore("my-key1", "my-key2"))
+	cache2 := newCache(scanStore("my-key2", "my-key3"))
+	cache3 := New[any](mocksStore.NewMockStoreInterface(ctrl))
+
+	cache := NewChain[any](cache1, cache2, cache3)
+
+	// When
+	var keys []string
+	err := cache.Scan(ctx, "my-*", func(key string) error {
+		keys = append(keys, key)
+		return nil
+	})
+
+	// Then
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"my-key1", "my-key2", "my-key3"}, keys)
+}
+
+func TestChainScanWhenUnsupported(t *testing.T) {
+	// Given
+	ctrl := gomock.NewController(t)
+
+	ctx := context.Background()
+
+	cache1 := mocksCache.NewMockSetterCacheInterface[any](ctrl)
+	cache2 := New[any](mocksStore.NewMockStoreInterface(ctrl))
+
+	cache := NewChain[any](cache1, cache2)
+
+	// When
+	err := cache.Scan(ctx, "my-*", func(key string) error {
+		return nil
+	})
+
+	// Then
+	assert.Equal(t, store.ErrUnsupportedOperation, err)
+}
+
+func TestChainDelete(t *testing.T) {
+	// Given
+	ctrl := gomock.NewController(t)
+
+	ctx := context.Background()
+
+	// Cache 1
+	cache1 := mocksCache.NewMockSetterCacheInterface[any](ctrl)
+	cache1.EXPECT().Delete(ctx, "my-key").Return(nil)
+
+	// Cache 2
+	cache2 := mocksCache.NewMockSetterCacheInterface[any](ctrl)
+	cache2.EXPECT().Delete(ctx, "my-key").Return(nil)
+
+	cache := NewChain[any](cache1, cache2)
+
+	// When
+	err := cache.Delete(ctx, "my-key")
+
+	// Then
+	assert.Nil(t, err)
+}
+
+func TestChainDeleteWhenError(t *testing.T) {
+	// Given
+	ctrl := gomock.NewController(t)
+
+	ctx := context.Background()
+
+	expectedErr := errors.New("an error has occurred while deleting key")
+
+	// Cache 1
+	store1 := mocksStore.NewMockStoreInterface(ctrl)
+	store1.EXPECT().GetType().Return("store1")
+
+	codec1 := mocksCodec.NewMockCodecInterface(ctrl)
+	codec1.EXPECT().GetStore().Return(store1)
+
+	cache1 := mocksCache.NewMockSetterCacheInterface[any](ctrl)
+	cache1.EXPECT().Delete(ctx, "my-key").Return(expectedErr)
+	cache1.EXPECT().GetCodec().Return(codec1)
+
+	// Cache 2
+	cache2 := mocksCache.NewMockSetterCacheInterface[any](ctrl)
+	cache2.EXPECT().Delete(ctx, "my-key").Return(nil)
+
+	cache := NewChain[any](cache1, cache2)
+
+	// When
+	err := cache.Delete(ctx, "my-key")
+
+	// Then
+	assert.EqualError(t, err, "error 1 of 1: Unable to delete item from cache with store 'store1': an error has occurred while deleting key")
+	assert.ErrorIs(t, err, expectedErr)
+
+	var layerErr *LayerError
+	assert.ErrorAs(t, err, &layerErr)
+	assert.Equal(t, 0, layerErr.Layer)
+	assert.Equal(t, "store1", layerErr.StoreType)
+}
+
+func TestChainDeleteWhenNotFound(t *testing.T) {
+	// Given
+	ctrl := gomock.NewController(t)
+
+	ctx := context.Background()
+
+	// Cache 1
+	cache1 := mocksCache.NewMockSetterCacheInterface[any](ctrl)
+	cache1.EXPECT().Delete(ctx, "my-key").Return(&store.NotFound{})
+
+	// Cache 2
+	cache2 := mocksCache.NewMockSetterCacheInterface[any](ctrl)
+	cache2.EXPECT().Delete(ctx, "my-key").Return(nil)
+
+	cache := NewChain[any](cache1, cache2)
+
+	// When
+	err := cache.Delete(ctx, "my-key")
+
+	// Then
+	assert.Nil(t, err)
+}
+
+func TestChainDeleteWhenAbsentFromStores(t *testing.T) {
+	// Given
+	ctx := context.Background()
+
+	bigcacheClient, err := bigcache.NewBigCache(bigcache.DefaultConfig(time.Minute))
+	assert.Nil(t, err)
+
+	cache := NewChain[any](
+		New[any](store.NewFreecache(freecache.NewCache(1024*1024))),
+		New[any](store.NewBigcache(bigcacheClient)),
+	)
+
+	// When
+	err = cache.Delete(ctx, "absent-key")
+
+	// Then
+	assert.Nil(t, err)
+	assert.Nil(t, cache.DeleteMany(ctx, []any{"absent-key", "other-absent-key"}))
+}
+
+func TestChainDeleteWithFailFast(t *testing.T) {
+	// Given
+	ctrl := gomock.NewController(t)
+
+	ctx := context.Background()
+
+	expectedErr := errors.New("an error has occurred while deleting key")
+
+	// Cache 1
+	store1 := mocksStore.NewMockStoreInterface(ctrl)
+	store1.EXPECT().GetType().Return("store1")
+
+	codec1 := mocksCodec.NewMockCodecInterface(ctrl)
+	codec1.EXPECT().GetStore().Return(store1)
+
+	cache1 := mocksCache.NewMockSetterCacheInterface[any](ctrl)
+	cache1.EXPECT().Delete(ctx, "my-key").Return(expectedErr)
+	cache1.EXPECT().GetCodec().Return(codec1)
+
+	// Cache 2
+	cache2 := mocksCache.NewMockSetterCacheInterface[any](ctrl)
+
+	cache := NewChainWithOptions[any]([]SetterCacheInterface[any]{cache1, cache2},
+		WithErrorPolicy(FailFast),
+	)
+
+	// When
+	err := cache.Delete(ctx, "my-key")
+
+	// Then
+	assert.ErrorIs(t, err, expectedErr)
+}
+
+func TestChainSetWithFailFast(t *testing.T) {
+	// Given
+	ctrl := gomock.NewController(t)
+
+	ctx := context.Background()
+
+	expectedErr := errors.New("an unexpected error occurred while setting data")
+
+	// Cache 1
+	store1 := mocksStore.NewMockStoreInterface(ctrl)
+	store1.EXPECT().GetType().Return("store1")
+
+	codec1 := mocksCodec.NewMockCodecInterface(ctrl)
+	codec1.EXPECT().GetStore().Return(store1)
+
+	cache1 := mocksCache.NewMockSetterCacheInterface[any](ctrl)
+	cache1.EXPECT().Set(ctx, "my-key", "my-value").Return(expectedErr)
+	cache1.EXPECT().GetCodec().Return(codec1)
+
+	// Cache 2
+	cache2 := mocksCache.NewMockSetterCacheInterface[any](ctrl)
+
+	cache := NewChainWithOptions[any]([]SetterCacheInterface[any]{cache1, cache2},
+		WithErrorPolicy(FailFast),
+	)
+
+	// When
+	err := cache.Set(ctx, "my-key", "my-value")
+
+	// Then
+	assert.EqualError(t, err, "error 1 of 1: Unable to set item into cache with store 'store1': an unexpected error occurred while setting data")
+}
+
+func TestChainInvalidate(t *testing.T) {
+	// Given
+	ctrl := gomock.NewController(t)
+
+	ctx := context.Background()
+
+	// Cache 1
+	cache1 := mocksCache.NewMockSetterCacheInterface[any](ctrl)
+	cache1.EXPECT().Invalidate(ctx).Return(nil)
+
+	// Cache 2
+	cache2 := mocksCache.NewMockSetterCacheInterface[any](ctrl)
+	cache2.EXPECT().Invalidate(ctx).Return(nil)
+
+	cache := NewChain[any](cache1, cache2)
+
+	// When
+	err := cache.Invalidate(ctx)
+
+	// Then
+	assert.Nil(t, err)
+}
+
+func TestChainInvalidateWhenError(t *testing.T) {
+	// Given
+	ctrl := gomock.NewController(t)
+
+	ctx := context.Background()
+
+	expectedErr := errors.New("an unexpected error has occurred while invalidation data")
+
+	// Cache 1
+	store1 := mocksStore.NewMockStoreInterface(ctrl)
+	store1.EXPECT().GetType().Return("store1")
+
+	codec1 := mocksCodec.NewMockCodecInterface(ctrl)
+	codec1.EXPECT().GetStore().Return(store1)
+
+	cache1 := mocksCache.NewMockSetterCacheInterface[any](ctrl)
+	cache1.EXPECT().Invalidate(ctx).Return(expectedErr)
+	cache1.EXPECT().GetCodec().Return(codec1)
+
+	// Cache 2
+	cache2 := mocksCache.NewMockSetterCacheInterface[any](ctrl)
+	cache2.EXPECT().Invalidate(ctx).Return(nil)
+
+	cache := NewChain[any](cache1, cache2)
+
+	// When
+	err := cache.Invalidate(ctx)
+
+	// Then
+	assert.EqualError(t, err, "error 1 of 1: Unable to invalidate cache with store 'store1': an unexpected error has occurred while invalidation data")
+	assert.ErrorIs(t, err, expectedErr)
+
+	var layerErr *LayerError
+	assert.ErrorAs(t, err, &layerErr)
+	assert.Equal(t, 0, layerErr.Layer)
+	assert.Equal(t, "store1", layerErr.StoreType)
+}
+
+func TestChainInvalidateWithDeletedCount(t *testing.T) {
+	// Given
+	ctrl := gomock.NewController(t)
+
+	ctx := context.Background()
+
+	deleteKeys := func(count int64) func(context.Context, ...store.InvalidateOption) error {
+		return func(_ context.Context, options ...store.InvalidateOption) error {
+			*store.InvalidateDeletedCount(options...) += count
+			return nil
+		}
+	}
+
+	// Cache 1
+	cache1 := mocksCache.NewMockSetterCacheInterface[any](ctrl)
+	cache1.EXPECT().Invalidate(ctx, gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(deleteKeys(2))
+
+	// Cache 2
+	cache2 := mocksCache.NewMockSetterCacheInterface[any](ctrl)
+	cache2.EXPECT().Invalidate(ctx, gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(deleteKeys(3))
+
+	cache := NewChain[any](cache1, cache2)
+
+	deleted := int64(1)
+
+	// When
+	err := cache.Invalidate(ctx, store.WithInvalidatePrefix("user:42:"), store.WithInvalidateDeletedCount(&deleted))
+
+	// Then
+	assert.Nil(t, err)
+	assert.Equal(t, int64(4), deleted)
+}
+
+func TestChainInvalidateWhenLayerIsUnsupported(t *testing.T) {
+	// Given
+	ctrl := gomock.NewController(t)
+
+	ctx := context.Background()
+
+	// Cache 1
+	cache1 := mocksCache.NewMockSetterCacheInterface[any](ctrl)
+	cache1.EXPECT().Invalidate(ctx, gomock.Any()).Return(store.ErrUnsupportedOperation)
+
+	// Cache 2
+	cache2 := mocksCache.NewMockSetterCacheInterface[any](ctrl)
+	cache2.EXPECT().Invalidate(ctx, gomock.Any()).Return(nil)
+
+	cache := NewChain[any](cache1, cache2)
+
+	// When
+	err := cache.Invalidate(ctx, store.WithInvalidatePattern("user:*"))
+
+	// Then
+	assert.Nil(t, err)
+}
+
+func TestChainInvalidateWhenAllLayersAreUnsupported(t *testing.T) {
+	// Given
+	ctrl := gomock.NewController(t)
+
+	ctx := context.Background()
+
+	// Cache 1
+	cache1 := mocksCache.NewMockSetterCacheInterface[any](ctrl)
+	cache1.EXPECT().Invalidate(ctx, gomock.Any()).Return(store.ErrUnsupportedOperation)
+
+	// Cache 2
+	cache2 := mocksCache.NewMockSetterCacheInterface[any](ctrl)
+	cache2.EXPECT().Invalidate(ctx, gomock.Any()).Return(store.ErrUnsupportedOperation)
+
+	cache := NewChain[any](cache1, cache2)
+
+	// When
+	err := cache.Invalidate(ctx, store.WithInvalidatePattern("user:*"))
+
+	// Then
+	assert.ErrorIs(t, err, store.ErrUnsupportedOperation)
+}
+
+func TestChainClear(t *testing.T) {
+	// Given
+	ctrl := gomock.NewController(t)
+
+	ctx := context.Background()
+
+	// Cache 1
+	cache1 := mocksCache.NewMockSetterCacheInterface[any](ctrl)
+	cache1.EXPECT().Clear(ctx).Return(nil)
+
+	// Cache 2
+	cache2 := mocksCache.NewMockSetterCacheInterface[any](ctrl)
+	cache2.EXPECT().Clear(ctx).Return(nil)
+
+	cache := NewChain[any](cache1, cache2)
+
+	// When
+	err := cache.Clear(ctx)
+
+	// Then
+	assert.Nil(t, err)
+}
+
+func TestChainClearWhenError(t *testing.T) {
+	// Given
+	ctrl := gomock.NewController(t)
+
+	ctx := context.Background()
+
+	expectedErr := errors.New("an unexpected error has occurred while invalidation data")
+
+	// Cache 1
+	store1 := mocksStore.NewMockStoreInterface(ctrl)
+	store1.EXPECT().GetType().Return("store1")
+
+	codec1 := mocksCodec.NewMockCodecInterface(ctrl)
+	codec1.EXPECT().GetStore().Return(store1)
+
+	cache1 := mocksCache.NewMockSetterCacheInterface[any](ctrl)
+	cache1.EXPECT().Clear(ctx).Return(expectedErr)
+	cache1.EXPECT().GetCodec().Return(codec1)
+
+	// Cache 2
+	cache2 := mocksCache.NewMockSetterCacheInterface[any](ctrl)
+	cache2.EXPECT().Clear(ctx).Return(nil)
+
+	cache := NewChain[any](cache1, cache2)
+
+	// When
+	err := cache.Clear(ctx)
+
+	// Then
+	assert.EqualError(t, err, "error 1 of 1: Unable to clear cache with store 'store1': an unexpected error has occurred while invalidation data")
+	assert.ErrorIs(t, err, expectedErr)
+
+	var layerErr *LayerError
+	assert.ErrorAs(t, err, &layerErr)
+	assert.Equal(t, 0, layerErr.Layer)
+	assert.Equal(t, "store1", layerErr.StoreType)
+}
+
+func TestChainFlush(t *testing.T) {
+	// Given
+	ctrl := gomock.NewController(t)
+
+	ctx := context.Background()
+
+	set := false
+
+	// Cache 1
+	cache1 := mocksCache.NewMockSetterCacheInterface[any](ctrl)
+	cache1.EXPECT().GetWithTTL(ctx, "my-key").Return(nil, 0*time.Second,
+		errors.New("unable to find in cache 1"))
+	cache1.EXPECT().Set(gomock.Any(), "my-key", "my-value", gomock.Any()).DoAndReturn(func(_, _, _ any, _ ...store.Option) error {
+		set = true
+		return nil
+	})
+
+	// Cache 2
+	cache2 := mocksCache.NewMockSetterCacheInterface[any](ctrl)
+	cache2.EXPECT().GetWithTTL(ctx, "my-key").Return("my-value", 5*time.Second, nil)
+
+	cache := NewChain[any](cache1, cache2)
+
+	_, err := cache.Get(ctx, "my-key")
+	assert.Nil(t, err)
+
+	// When
+	err = cache.Flush(ctx)
+
+	// Then
+	assert.Nil(t, err)
+	assert.True(t, set)
+}
+
+func TestChainClose(t *testing.T) {
+	// Given
+	ctrl := gomock.NewController(t)
+
+	ctx := context.Background()
+
+	set := 0
+
+	// Cache 1
+	cache1 := mocksCache.NewMockSetterCacheInterface[any](ctrl)
+	cache1.EXPECT().GetWithTTL(ctx, "my-key").Return(nil, 0*time.Second,
+		errors.New("unable to find in cache 1")).Times(2)
+	cache1.EXPECT().Set(gomock.Any(), "my-key", "my-value", gomock.Any()).DoAndReturn(func(_, _, _ any, _ ...store.Option) error {
+		set++
+		return nil
+	}).Times(2)
+
+	// Cache 2
+	cache2 := mocksCache.NewMockSetterCacheInterface[any](ctrl)
+	cache2.EXPECT().GetWithTTL(ctx, "my-key").Return("my-value", 5*time.Second, nil).Times(2)
+
+	cache := NewChain[any](cache1, cache2)
+
+	_, err := cache.Get(ctx, "my-key")
+	assert.Nil(t, err)
+
+	// When
+	err = cache.Close()
+
+	// Then
+	assert.Nil(t, err)
+	assert.Equal(t, 1, set)
+
+	// Values are set synchronously once closed
+	_, err = cache.Get(ctx, "my-key")
+	assert.Nil(t, err)
+	assert.Equal(t, 2, set)
+
+	assert.Nil(t, cache.Close())
+	assert.Nil(t, cache.Flush(ctx))
 }
 
 func TestChainGetType(t *testing.T) {
@@ -449,7 +1483,7 @@ func TestChainSetWhenErrorInChain(t *testing.T) {
 	// When - Then
 	err := cache.Set(ctx, key, value, nil)
 
-	expErr := errors.New("error 1 of 1: Unable to set item into cache with store 'store1': an issue occurred with the cache")
 	// Then
-	assert.Equal(t, expErr, err)
+	assert.EqualError(t, err, "error 1 of 1: Unable to set item into cache with store 'store1': an issue occurred with the cache")
+	assert.ErrorIs(t, err, interError)
 }
@@ -0,0 +1,50 @@
+package cache
+
+import (
+	"io"
+	"reflect"
+
+	"github.com/eko/gocache/v3/codec"
+)
+
+// CloseAll closes the given cache and, recursively, the caches, stores and
+// metrics providers it is built on top of which implement io.Closer. Outer
+// caches are closed first so that the values they set in background are set
+// before their layers are closed. Components shared by several caches are
+// closed once. It keeps closing the remaining components when one of them
+// fails and returns the first error.
+func CloseAll(cache any) error {
+	var err error
+	closeAll(cache, make(map[any]struct{}), &err)
+
+	return err
+}
+
+func closeAll(component any, closed map[any]struct{}, err *error) {
+	if component == nil {
+		return
+	}
+
+	if reflect.TypeOf(component).Comparable() {
+		if _, ok := closed[component]; ok {
+			return
+		}
+		closed[component] = struct{}{}
+	}
+
+	if closer, ok := component.(io.Closer); ok {
+		if closeErr := closer.Close(); closeErr != nil && *err == nil {
+			*err = closeErr
+		}
+	}
+
+	if composed, ok := component.(ComposedCacheInterface); ok {
+		for _, child := range composed.GetComponents() {
+			closeAll(child, closed, err)
+		}
+	}
+
+	if codecCache, ok := component.(interface{ GetCodec() codec.CodecInterface }); ok {
+		closeAll(codecCache.GetCodec().GetStore(), closed, err)
+	}
+}
@@ -0,0 +1,85 @@
+package cache
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/eko/gocache/v3/store"
+	mocksMetrics "github.com/eko/gocache/v3/test/mocks/metrics"
+	mocksStore "github.com/eko/gocache/v3/test/mocks/store"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+// closableStore is a store recording the order in which it is closed
+type closableStore struct {
+	store.StoreInterface
+	name   string
+	closed *[]string
+	err    error
+}
+
+func (s *closableStore) Close() error {
+	*s.closed = append(*s.closed, s.name)
+	return s.err
+}
+
+// closableMetrics is a metrics provider recording whether it is closed
+type closableMetrics struct {
+	*mocksMetrics.MockMetricsInterface
+	closed *[]string
+}
+
+func (m *closableMetrics) Close() error {
+	*m.closed = append(*m.closed, "metrics")
+	return nil
+}
+
+func TestCloseAll(t *testing.T) {
+	// Given
+	ctrl := gomock.NewController(t)
+
+	closed := []string{}
+
+	store1 := &closableStore{StoreInterface: mocksStore.NewMockStoreInterface(ctrl), name: "store1", closed: &closed}
+	store2 := &closableStore{StoreInterface: mocksStore.NewMockStoreInterface(ctrl), name: "store2", closed: &closed}
+	metrics := &closableMetrics{MockMetricsInterface: mocksMetrics.NewMockMetricsInterface(ctrl), closed: &closed}
+
+	loadFunc := func(_ context.Context, key any) (any, error) {
+		return "a value", nil
+	}
+
+	// The second store is shared by two caches
+	chain := NewChain[any](New[any](store1), New[any](store2), New[any](store2))
+	cache := NewMetric[any](metrics, NewLoadable[any](loadFunc, NewNamespaced[any](chain, "my-namespace")))
+
+	// When
+	err := CloseAll(NewKeyed[string, any](cache, StringKeyEncoder[string]))
+
+	// Then
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"metrics", "store1", "store2"}, closed)
+	assert.True(t, chain.closed)
+}
+
+func TestCloseAllWhenError(t *testing.T) {
+	// Given
+	ctrl := gomock.NewController(t)
+
+	closed := []string{}
+
+	expectedErr := errors.New("unable to close store")
+
+	store1 := &closableStore{StoreInterface: mocksStore.NewMockStoreInterface(ctrl), name: "store1", closed: &closed, err: expectedErr}
+	store2 := &closableStore{StoreInterface: mocksStore.NewMockStoreInterface(ctrl), name: "store2", closed: &closed}
+
+	cache := NewChain[any](New[any](store1), New[any](store2))
+
+	// When
+	err := CloseAll(cache)
+
+	// Then
+	assert.Equal(t, expectedErr, err)
+	assert.Equal(t, []string{"store1", "store2"}, closed)
+}
@@ -0,0 +1,81 @@
+package cache
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+)
+
+// ErrTypeMismatch is matched by the errors returned when a value retrieved
+// from the store does not have the type of the cache
+var ErrTypeMismatch = errors.New("value type does not match cache type")
+
+// TypeMismatchError is returned when a value retrieved from the store does
+// not have the type of the cache and cannot be converted to it
+type TypeMismatchError struct {
+	Key      any
+	Expected reflect.Type
+	Actual   reflect.Type
+}
+
+// Error returns the error message including the expected and actual types
+func (e *TypeMismatchError) Error() string {
+	return fmt.Sprintf("%s: expected %s, got %s", ErrTypeMismatch, e.Expected, e.Actual)
+}
+
+// Unwrap returns ErrTypeMismatch so that the error can be checked using errors.Is
+func (e *TypeMismatchError) Unwrap() error {
+	return ErrTypeMismatch
+}
+
+// Converter converts a value retrieved from the store to the given type. It
+// returns false when the value cannot be converted.
+type Converter func(value any, to reflect.Type) (any, bool)
+
+// StringBytesConverter converts strings to byte slices and byte slices to
+// strings, including types based on them. It allows caches of byte slices to
+// be used over stores returning strings, such as Redis, and conversely.
+func StringBytesConverter(value any, to reflect.Type) (any, bool) {
+	from := reflect.ValueOf(value)
+	if !isStringOrBytes(from.Type()) || !isStringOrBytes(to) || !from.Type().ConvertibleTo(to) {
+		return nil, false
+	}
+
+	return from.Convert(to).Interface(), true
+}
+
+// isStringOrBytes returns whether the given type is based on a string or on a
+// byte slice
+func isStringOrBytes(t reflect.Type) bool {
+	return t.Kind() == reflect.String || (t.Kind() == reflect.Slice && t.Elem().Kind() == reflect.Uint8)
+}
+
+// convertValue returns the given value retrieved from the store as a T,
+// using the given converter when it has another type. A TypeMismatchError is
+// returned when it cannot be converted.
+func convertValue[T any](key any, value any, converter Converter) (T, error) {
+	if v, ok := value.(T); ok {
+		return v, nil
+	}
+
+	// A nil value is the zero value of any type
+	if value == nil {
+		return *new(T), nil
+	}
+
+	expected := reflect.TypeOf((*T)(nil)).Elem()
+
+	if converter != nil {
+		if converted, ok := converter(value, expected); ok {
+			if v, ok := converted.(T); ok {
+				return v, nil
+			}
+		}
+	}
+
+	return *new(T), &TypeMismatchError{
+		Key:      key,
+		Expected: expected,
+		Actual:   reflect.TypeOf(value),
+	}
+}
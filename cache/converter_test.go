@@ -0,0 +1,54 @@
+package cache
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStringBytesConverter(t *testing.T) {
+	testCases := []struct {
+		value    any
+		to       reflect.Type
+		expected any
+		ok       bool
+	}{
+		{value: "my-value", to: reflect.TypeOf([]byte{}), expected: []byte("my-value"), ok: true},
+		{value: []byte("my-value"), to: reflect.TypeOf(""), expected: "my-value", ok: true},
+		{value: `{"a":1}`, to: reflect.TypeOf(json.RawMessage{}), expected: json.RawMessage(`{"a":1}`), ok: true},
+		{value: 42, to: reflect.TypeOf(""), ok: false},
+		{value: "42", to: reflect.TypeOf(0), ok: false},
+	}
+
+	for _, tc := range testCases {
+		// When
+		value, ok := StringBytesConverter(tc.value, tc.to)
+
+		// Then
+		assert.Equal(t, tc.ok, ok)
+		assert.Equal(t, tc.expected, value)
+	}
+}
+
+func TestConvertValueWhenNil(t *testing.T) {
+	// When
+	value, err := convertValue[[]byte]("my-key", nil, nil)
+
+	// Then
+	assert.Nil(t, err)
+	assert.Nil(t, value)
+}
+
+func TestTypeMismatchErrorMessage(t *testing.T) {
+	// Given
+	err := &TypeMismatchError{
+		Key:      "my-key",
+		Expected: reflect.TypeOf([]byte{}),
+		Actual:   reflect.TypeOf(""),
+	}
+
+	// When - Then
+	assert.Equal(t, "value type does not match cache type: expected []uint8, got string", err.Error())
+}
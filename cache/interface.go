@@ -18,6 +18,68 @@ type CacheInterface[T any] interface {
 	GetType() string
 }
 
+// BulkCacheInterface represents the interface for caches able to handle
+// several keys at once
+type BulkCacheInterface[T any] interface {
+	GetMany(ctx context.Context, keys []any) (map[any]T, error)
+	SetMany(ctx context.Context, items map[any]T, options ...store.Option) error
+	DeleteMany(ctx context.Context, keys []any) error
+}
+
+// BulkTTLCacheInterface represents the interface for caches able to return
+// several keys along with their remaining TTL at once
+type BulkTTLCacheInterface[T any] interface {
+	GetManyWithTTL(ctx context.Context, keys []any) (map[any]T, map[any]time.Duration, error)
+}
+
+// ConditionalCacheInterface represents the interface for caches able to set
+// a value only when its key does not exist yet
+type ConditionalCacheInterface[T any] interface {
+	SetIfNotExists(ctx context.Context, key any, object T, options ...store.Option) error
+}
+
+// VersionedCacheInterface represents the interface for caches handling
+// optimistic concurrency using version tokens
+type VersionedCacheInterface[T any] interface {
+	GetWithVersion(ctx context.Context, key any) (T, store.Version, error)
+	SetIfVersion(ctx context.Context, key any, object T, version store.Version, options ...store.Option) error
+	DeleteIfVersion(ctx context.Context, key any, version store.Version) error
+}
+
+// KeyCacheInterface represents the interface for caches able to check the
+// existence of keys and to update their expiration
+type KeyCacheInterface interface {
+	Exists(ctx context.Context, key any) (bool, error)
+	Touch(ctx context.Context, key any, ttl time.Duration) error
+}
+
+// ScanCacheInterface represents the interface for caches able to enumerate
+// the keys matching a glob-style pattern
+type ScanCacheInterface interface {
+	Scan(ctx context.Context, pattern string, fn func(key string) error) error
+}
+
+// CounterCacheInterface represents the interface for caches able to
+// atomically increment integer values
+type CounterCacheInterface interface {
+	Increment(ctx context.Context, key any, delta int64, options ...store.Option) (int64, error)
+	Decrement(ctx context.Context, key any, delta int64, options ...store.Option) (int64, error)
+	GetCounter(ctx context.Context, key any) (int64, error)
+	SetCounterIfNotExists(ctx context.Context, key any, counter int64, options ...store.Option) error
+}
+
+// FlushCacheInterface represents the interface for caches setting values in
+// background, able to wait for the queued ones to be set
+type FlushCacheInterface interface {
+	Flush(ctx context.Context) error
+}
+
+// ComposedCacheInterface represents the interface for caches built on top of
+// other caches, stores or metrics providers, closed along with them by CloseAll
+type ComposedCacheInterface interface {
+	GetComponents() []any
+}
+
 type CacheKeyGenerator interface {
 	GetCacheKey() string
 }
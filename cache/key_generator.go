@@ -0,0 +1,176 @@
+package cache
+
+import (
+	"crypto"
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/cespare/xxhash/v2"
+)
+
+// KeyGenerator computes the cache key of key objects which are neither
+// strings nor CacheKeyGenerator implementations
+type KeyGenerator func(key any) string
+
+// KeyGeneratorCacheInterface represents the interface for caches computing
+// the keys of key objects with a configurable key generator
+type KeyGeneratorCacheInterface interface {
+	GetKeyGenerator() KeyGenerator
+}
+
+// ChecksumKeyGenerator computes cache keys as the MD5 checksum of the type and
+// the printed representation of key objects. It is the default key generator
+// and is kept for compatibility: printed maps and pointers make equal objects
+// produce different keys.
+func ChecksumKeyGenerator(key any) string {
+	return checksum(key)
+}
+
+// SHA256KeyGenerator computes cache keys as the SHA-256 hash of the type and
+// the canonical JSON representation of key objects, in which pointers are
+// followed and map keys are sorted. Only exported fields are taken into account.
+// Key objects which cannot be encoded in JSON, such as maps with struct keys or
+// values referencing themselves, use their readable representation instead.
+func SHA256KeyGenerator(key any) string {
+	return fmt.Sprintf("%x", sha256.Sum256(canonicalKey(key)))
+}
+
+// XXHashKeyGenerator computes cache keys as the xxHash digest of the type and
+// the canonical JSON representation of key objects, which is faster than
+// SHA-256 but not collision resistant.
+func XXHashKeyGenerator(key any) string {
+	return strconv.FormatUint(xxhash.Sum64(canonicalKey(key)), 16)
+}
+
+// ReadableKeyGenerator computes human readable cache keys using the
+// "type:field=value,..." form, for instance "User:ID=42,Name=john". Pointers are
+// followed and map keys are sorted. The separators found in values are escaped
+// with a backslash so that distinct key objects do not produce the same key,
+// and values referencing themselves are written as "{...}" where they loop.
+func ReadableKeyGenerator(key any) string {
+	value := reflect.Indirect(reflect.ValueOf(key))
+	if !value.IsValid() {
+		return "nil"
+	}
+
+	name := value.Type().Name()
+	if name == "" {
+		name = value.Type().String()
+	}
+
+	readable := readableValue(reflect.ValueOf(key), make(map[readableVisit]struct{}))
+	if value.Kind() == reflect.Struct {
+		readable = strings.TrimSuffix(strings.TrimPrefix(readable, "{"), "}")
+	}
+
+	return name + ":" + readable
+}
+
+// canonicalKey returns the type and the canonical JSON representation of the
+// given key object, or its readable representation when it cannot be encoded,
+// which also follows pointers instead of printing their addresses
+func canonicalKey(key any) []byte {
+	encoded, err := json.Marshal(key)
+	if err != nil {
+		encoded = []byte(readableValue(reflect.ValueOf(key), make(map[readableVisit]struct{})))
+	}
+
+	return append([]byte(fmt.Sprint(reflect.TypeOf(key))+":"), encoded...)
+}
+
+// readableEscaper escapes the separators of readable keys found in values
+var readableEscaper = strings.NewReplacer(
+	`\`, `\\`,
+	",", `\,`,
+	"=", `\=`,
+	"{", `\{`,
+	"}", `\}`,
+	"[", `\[`,
+	"]", `\]`,
+)
+
+// readableVisit identifies a pointer, map or slice being written, in order to
+// detect the values referencing themselves
+type readableVisit struct {
+	pointer uintptr
+	typ     reflect.Type
+	len     int
+}
+
+// readableValue returns the readable representation of the given value.
+// visiting holds the references being written by the callers.
+func readableValue(value reflect.Value, visiting map[readableVisit]struct{}) string {
+	for value.Kind() == reflect.Pointer || value.Kind() == reflect.Interface {
+		if value.IsNil() {
+			return "nil"
+		}
+		if value.Kind() == reflect.Pointer {
+			visit := readableVisit{pointer: value.Pointer(), typ: value.Type()}
+			if _, ok := visiting[visit]; ok {
+				return "{...}"
+			}
+			visiting[visit] = struct{}{}
+			defer delete(visiting, visit)
+		}
+		value = value.Elem()
+	}
+
+	switch value.Kind() {
+	case reflect.Map, reflect.Slice:
+		if value.IsNil() {
+			break
+		}
+		visit := readableVisit{pointer: value.Pointer(), typ: value.Type(), len: value.Len()}
+		if _, ok := visiting[visit]; ok {
+			return "{...}"
+		}
+		visiting[visit] = struct{}{}
+		defer delete(visiting, visit)
+	}
+
+	switch value.Kind() {
+	case reflect.Struct:
+		fields := make([]string, 0, value.NumField())
+		for i := 0; i < value.NumField(); i++ {
+			field := value.Type().Field(i)
+			if !field.IsExported() {
+				continue
+			}
+			fields = append(fields, field.Name+"="+readableValue(value.Field(i), visiting))
+		}
+		return "{" + strings.Join(fields, ",") + "}"
+
+	case reflect.Map:
+		entries := make([]string, 0, value.Len())
+		iterator := value.MapRange()
+		for iterator.Next() {
+			entries = append(entries, readableValue(iterator.Key(), visiting)+"="+readableValue(iterator.Value(), visiting))
+		}
+		sort.Strings(entries)
+		return "{" + strings.Join(entries, ",") + "}"
+
+	case reflect.Slice, reflect.Array:
+		items := make([]string, value.Len())
+		for i := range items {
+			items[i] = readableValue(value.Index(i), visiting)
+		}
+		return "[" + strings.Join(items, ",") + "]"
+	}
+
+	return readableEscaper.Replace(fmt.Sprint(value.Interface()))
+}
+
+// checksum hashes a given object into a string
+func checksum(object any) string {
+	digester := crypto.MD5.New()
+	fmt.Fprint(digester, reflect.TypeOf(object))
+	fmt.Fprint(digester, object)
+	hash := digester.Sum(nil)
+
+	return fmt.Sprintf("%x", hash)
+}
@@ -0,0 +1,140 @@
+package cache
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type keyGeneratorTestUser struct {
+	ID     int
+	Name   string
+	Labels map[string]string
+	Parent *keyGeneratorTestUser
+}
+
+func newKeyGeneratorTestUser() *keyGeneratorTestUser {
+	return &keyGeneratorTestUser{
+		ID:   42,
+		Name: "john",
+		Labels: map[string]string{
+			"team": "cache",
+			"role": "admin",
+			"site": "paris",
+		},
+		Parent: &keyGeneratorTestUser{ID: 1, Name: "jane"},
+	}
+}
+
+func TestSHA256KeyGenerator(t *testing.T) {
+	// Given
+	user1 := newKeyGeneratorTestUser()
+	user2 := newKeyGeneratorTestUser()
+
+	// When
+	key1 := SHA256KeyGenerator(user1)
+	key2 := SHA256KeyGenerator(user2)
+
+	// Then
+	assert.Len(t, key1, 64)
+	assert.Equal(t, key1, key2)
+	assert.NotEqual(t, key1, SHA256KeyGenerator(&keyGeneratorTestUser{ID: 43}))
+	assert.NotEqual(t, SHA256KeyGenerator(42), SHA256KeyGenerator("42"))
+}
+
+func TestSHA256KeyGeneratorWhenKeyCannotBeEncoded(t *testing.T) {
+	// Given
+	type filter struct {
+		Field string
+	}
+	type query struct {
+		Filters map[filter]*int
+	}
+
+	newQuery := func(value int) query {
+		return query{Filters: map[filter]*int{{Field: "age"}: &value}}
+	}
+
+	// When
+	key1 := SHA256KeyGenerator(newQuery(42))
+	key2 := SHA256KeyGenerator(newQuery(42))
+
+	// Then
+	assert.Equal(t, key1, key2)
+	assert.NotEqual(t, key1, SHA256KeyGenerator(newQuery(43)))
+}
+
+func TestXXHashKeyGenerator(t *testing.T) {
+	// Given
+	user1 := newKeyGeneratorTestUser()
+	user2 := newKeyGeneratorTestUser()
+
+	// When
+	key1 := XXHashKeyGenerator(user1)
+	key2 := XXHashKeyGenerator(user2)
+
+	// Then
+	assert.Equal(t, key1, key2)
+	assert.NotEqual(t, key1, XXHashKeyGenerator(&keyGeneratorTestUser{ID: 43}))
+}
+
+func TestReadableKeyGenerator(t *testing.T) {
+	// Given
+	user := newKeyGeneratorTestUser()
+
+	// When
+	key := ReadableKeyGenerator(user)
+
+	// Then
+	assert.Equal(t, "keyGeneratorTestUser:ID=42,Name=john,Labels={role=admin,site=paris,team=cache},Parent={ID=1,Name=jane,Labels={},Parent=nil}", key)
+	assert.Equal(t, "int:42", ReadableKeyGenerator(42))
+	assert.Equal(t, "[]string:[a,b]", ReadableKeyGenerator([]string{"a", "b"}))
+	assert.Equal(t, "nil", ReadableKeyGenerator(nil))
+}
+
+func TestReadableKeyGeneratorWhenValuesContainSeparators(t *testing.T) {
+	// Given
+	user1 := &keyGeneratorTestUser{Name: "john,ID=43"}
+	user2 := &keyGeneratorTestUser{Labels: map[string]string{"a": "b,c=d"}}
+	user3 := &keyGeneratorTestUser{Labels: map[string]string{"a": "b", "c": "d"}}
+
+	// When
+	key1 := ReadableKeyGenerator(user1)
+	key2 := ReadableKeyGenerator(user2)
+	key3 := ReadableKeyGenerator(user3)
+
+	// Then
+	assert.Equal(t, `keyGeneratorTestUser:ID=0,Name=john\,ID\=43,Labels={},Parent=nil`, key1)
+	assert.NotEqual(t, key2, key3)
+	assert.NotEqual(t, ReadableKeyGenerator([]string{"a,b"}), ReadableKeyGenerator([]string{"a", "b"}))
+	assert.NotEqual(t, ReadableKeyGenerator([]string{`a\`, "b"}), ReadableKeyGenerator([]string{`a\,b`}))
+}
+
+func TestReadableKeyGeneratorWhenValueIsCyclic(t *testing.T) {
+	// Given
+	user := &keyGeneratorTestUser{ID: 42, Name: "john"}
+	user.Parent = user
+
+	labels := map[string]any{"team": "cache"}
+	labels["self"] = labels
+
+	// When
+	key1 := ReadableKeyGenerator(user)
+	key2 := ReadableKeyGenerator(labels)
+
+	// Then
+	assert.Equal(t, "keyGeneratorTestUser:ID=42,Name=john,Labels={},Parent={...}", key1)
+	assert.Equal(t, "map[string]interface {}:{self={...},team=cache}", key2)
+}
+
+func TestChecksumKeyGenerator(t *testing.T) {
+	// Given
+	key := &struct {
+		Hello string
+	}{
+		Hello: "world",
+	}
+
+	// When - Then
+	assert.Equal(t, "8144fe5310cf0e62ac83fd79c113aad2", ChecksumKeyGenerator(key))
+}
@@ -0,0 +1,236 @@
+package cache
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/eko/gocache/v3/metrics"
+	"github.com/eko/gocache/v3/store"
+	"golang.org/x/exp/constraints"
+)
+
+const (
+	// KeyedType represents the keyed cache type as a string value
+	KeyedType = "keyed"
+)
+
+// ErrUntypedKey is returned when a keyed loadable cache is asked to load a key
+// which has not been given through its typed methods
+var ErrUntypedKey = errors.New("key is not a typed key of the keyed cache")
+
+// KeyEncoder returns the cache key of a typed key
+type KeyEncoder[K comparable] func(key K) string
+
+// KeyedLoadFunction loads the value of a typed key to be put in cache
+type KeyedLoadFunction[K comparable, V any] func(ctx context.Context, key K) (V, error)
+
+// KeyedLoadManyFunction loads the values of several typed keys at once. Keys
+// that do not exist in the source can be omitted from the returned map.
+type KeyedLoadManyFunction[K comparable, V any] func(ctx context.Context, keys []K) (map[K]V, error)
+
+// StringKeyEncoder encodes keys whose type is based on string
+func StringKeyEncoder[K ~string](key K) string {
+	return string(key)
+}
+
+// IntegerKeyEncoder encodes keys whose type is based on an integer
+func IntegerKeyEncoder[K constraints.Integer](key K) string {
+	if key < 0 {
+		return strconv.FormatInt(int64(key), 10)
+	}
+
+	return strconv.FormatUint(uint64(key), 10)
+}
+
+// encodedKey is the key given to the wrapped cache. It holds the typed key,
+// for load functions, and its encoded form, used as cache key.
+type encodedKey[K comparable] struct {
+	key     K
+	encoded string
+}
+
+// GetCacheKey returns the encoded key
+func (k encodedKey[K]) GetCacheKey() string {
+	return k.encoded
+}
+
+// typedKey returns the typed key held by the given key of the wrapped cache
+func typedKey[K comparable](key any) (K, error) {
+	encoded, ok := key.(encodedKey[K])
+	if !ok {
+		var zero K
+		return zero, fmt.Errorf("%w: %T", ErrUntypedKey, key)
+	}
+
+	return encoded.key, nil
+}
+
+// KeyedCache is a cache whose keys are strongly typed, so that key type
+// mistakes are caught at compile time. Keys are converted to cache keys
+// using the given encoder before calling the wrapped cache.
+type KeyedCache[K comparable, V any] struct {
+	cache   CacheInterface[V]
+	encoder KeyEncoder[K]
+}
+
+// NewKeyed instantiates a new cache with typed keys on top of the given cache
+func NewKeyed[K comparable, V any](cache CacheInterface[V], encoder KeyEncoder[K]) *KeyedCache[K, V] {
+	return &KeyedCache[K, V]{
+		cache:   cache,
+		encoder: encoder,
+	}
+}
+
+// NewKeyedChain instantiates a new chained cache with typed keys
+func NewKeyedChain[K comparable, V any](encoder KeyEncoder[K], caches ...SetterCacheInterface[V]) *KeyedCache[K, V] {
+	return NewKeyed[K, V](NewChain(caches...), encoder)
+}
+
+// NewKeyedLoadable instantiates a new loadable cache with typed keys, whose
+// load function receives the typed keys
+func NewKeyedLoadable[K comparable, V any](loadFunc KeyedLoadFunction[K, V], cache CacheInterface[V], encoder KeyEncoder[K], options ...LoadableOption) *KeyedCache[K, V] {
+	load := func(ctx context.Context, key any) (V, error) {
+		typed, err := typedKey[K](key)
+		if err != nil {
+			var zero V
+			return zero, err
+		}
+
+		return loadFunc(ctx, typed)
+	}
+
+	return NewKeyed[K, V](NewLoadable[V](load, cache, options...), encoder)
+}
+
+// NewKeyedMetric instantiates a new cache with typed keys recording metrics
+func NewKeyedMetric[K comparable, V any](metrics metrics.MetricsInterface, cache CacheInterface[V], encoder KeyEncoder[K]) *KeyedCache[K, V] {
+	return NewKeyed[K, V](NewMetric(metrics, cache), encoder)
+}
+
+// WithKeyedLoadManyFunction allows to specify a function used by the GetMany
+// method of a keyed loadable cache to load all the missing typed keys at once
+func WithKeyedLoadManyFunction[K comparable, V any](loadManyFunc KeyedLoadManyFunction[K, V]) LoadableOption {
+	return WithLoadManyFunction[V](func(ctx context.Context, keys []any) (map[any]V, error) {
+		typedKeys := make([]K, len(keys))
+		encodedKeys := make(map[K]any, len(keys))
+		for i, key := range keys {
+			typed, err := typedKey[K](key)
+			if err != nil {
+				return nil, err
+			}
+
+			typedKeys[i] = typed
+			encodedKeys[typed] = key
+		}
+
+		values, err := loadManyFunc(ctx, typedKeys)
+		if err != nil {
+			return nil, err
+		}
+
+		objects := make(map[any]V, len(values))
+		for key, value := range values {
+			if encoded, ok := encodedKeys[key]; ok {
+				objects[encoded] = value
+			}
+		}
+
+		return objects, nil
+	})
+}
+
+// Get returns the object stored in cache for the given key if it exists
+func (c *KeyedCache[K, V]) Get(ctx context.Context, key K) (V, error) {
+	return c.cache.Get(ctx, c.key(key))
+}
+
+// Set populates the cache item using the given key
+func (c *KeyedCache[K, V]) Set(ctx context.Context, key K, object V, options ...store.Option) error {
+	return c.cache.Set(ctx, c.key(key), object, options...)
+}
+
+// GetMany returns the objects stored in cache for the given keys. Keys that
+// are not found are omitted from the returned map.
+func (c *KeyedCache[K, V]) GetMany(ctx context.Context, keys []K) (map[K]V, error) {
+	encodedKeys := make([]any, len(keys))
+	for i, key := range keys {
+		encodedKeys[i] = c.key(key)
+	}
+
+	values, err := getMany(ctx, c.cache, encodedKeys)
+	if err != nil {
+		return nil, err
+	}
+
+	objects := make(map[K]V, len(values))
+	for key, value := range values {
+		typed, err := typedKey[K](key)
+		if err != nil {
+			return nil, err
+		}
+
+		objects[typed] = value
+	}
+
+	return objects, nil
+}
+
+// SetMany populates the cache items using the given keys
+func (c *KeyedCache[K, V]) SetMany(ctx context.Context, items map[K]V, options ...store.Option) error {
+	encodedItems := make(map[any]V, len(items))
+	for key, object := range items {
+		encodedItems[c.key(key)] = object
+	}
+
+	return setMany(ctx, c.cache, encodedItems, options...)
+}
+
+// DeleteMany removes the cache items using the given keys
+func (c *KeyedCache[K, V]) DeleteMany(ctx context.Context, keys []K) error {
+	encodedKeys := make([]any, len(keys))
+	for i, key := range keys {
+		encodedKeys[i] = c.key(key)
+	}
+
+	return deleteMany(ctx, c.cache, encodedKeys)
+}
+
+// Delete removes the cache item using the given key
+func (c *KeyedCache[K, V]) Delete(ctx context.Context, key K) error {
+	return c.cache.Delete(ctx, c.key(key))
+}
+
+// Invalidate invalidates cache items from given options
+func (c *KeyedCache[K, V]) Invalidate(ctx context.Context, options ...store.InvalidateOption) error {
+	return c.cache.Invalidate(ctx, options...)
+}
+
+// Clear resets all cache data
+func (c *KeyedCache[K, V]) Clear(ctx context.Context) error {
+	return c.cache.Clear(ctx)
+}
+
+// GetCache returns the wrapped cache
+func (c *KeyedCache[K, V]) GetCache() CacheInterface[V] {
+	return c.cache
+}
+
+// GetComponents returns the wrapped cache
+func (c *KeyedCache[K, V]) GetComponents() []any {
+	return []any{c.cache}
+}
+
+// GetType returns the cache type
+func (c *KeyedCache[K, V]) GetType() string {
+	return KeyedType
+}
+
+// key returns the key given to the wrapped cache for the given typed key
+func (c *KeyedCache[K, V]) key(key K) encodedKey[K] {
+	return encodedKey[K]{
+		key:     key,
+		encoded: c.encoder(key),
+	}
+}
@@ -0,0 +1,199 @@
+package cache
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/eko/gocache/v3/store"
+	mocksCache "github.com/eko/gocache/v3/test/mocks/cache"
+	mocksStore "github.com/eko/gocache/v3/test/mocks/store"
+	"github.com/golang/mock/gomock"
+	gocache "github.com/patrickmn/go-cache"
+	"github.com/stretchr/testify/assert"
+)
+
+type keyedTestUserID int
+
+func keyedTestUserKey(id keyedTestUserID) string {
+	return fmt.Sprintf("user:%d", id)
+}
+
+func TestNewKeyed(t *testing.T) {
+	// Given
+	ctrl := gomock.NewController(t)
+
+	cache1 := mocksCache.NewMockCacheInterface[string](ctrl)
+
+	// When
+	cache := NewKeyed[keyedTestUserID, string](cache1, keyedTestUserKey)
+
+	// Then
+	assert.IsType(t, new(KeyedCache[keyedTestUserID, string]), cache)
+	assert.Equal(t, cache1, cache.GetCache())
+	assert.Equal(t, KeyedType, cache.GetType())
+}
+
+func TestKeyedGet(t *testing.T) {
+	// Given
+	ctrl := gomock.NewController(t)
+
+	ctx := context.Background()
+
+	mockedStore := mocksStore.NewMockStoreInterface(ctrl)
+	mockedStore.EXPECT().Get(ctx, "user:42").Return("john", nil)
+
+	cache := NewKeyed[keyedTestUserID, string](New[string](mockedStore), keyedTestUserKey)
+
+	// When
+	value, err := cache.Get(ctx, 42)
+
+	// Then
+	assert.Nil(t, err)
+	assert.Equal(t, "john", value)
+}
+
+func TestKeyedSet(t *testing.T) {
+	// Given
+	ctrl := gomock.NewController(t)
+
+	ctx := context.Background()
+
+	mockedStore := mocksStore.NewMockStoreInterface(ctrl)
+	mockedStore.EXPECT().Set(ctx, "42", "john", store.OptionsMatcher{
+		Expiration: 5 * time.Second,
+	}).Return(nil)
+
+	cache := NewKeyed[keyedTestUserID, string](New[string](mockedStore), IntegerKeyEncoder[keyedTestUserID])
+
+	// When
+	err := cache.Set(ctx, 42, "john", store.WithExpiration(5*time.Second))
+
+	// Then
+	assert.Nil(t, err)
+}
+
+func TestKeyedGetMany(t *testing.T) {
+	// Given
+	ctrl := gomock.NewController(t)
+
+	ctx := context.Background()
+
+	bulkStore := mocksStore.NewMockBulkStoreInterface(ctrl)
+	bulkStore.EXPECT().GetMany(ctx, []any{"user:42", "user:43"}).Return(map[any]any{
+		"user:42": "john",
+	}, nil)
+
+	cache := NewKeyed[keyedTestUserID, string](New[string](&struct {
+		*mocksStore.MockStoreInterface
+		*mocksStore.MockBulkStoreInterface
+	}{
+		mocksStore.NewMockStoreInterface(ctrl),
+		bulkStore,
+	}), keyedTestUserKey)
+
+	// When
+	values, err := cache.GetMany(ctx, []keyedTestUserID{42, 43})
+
+	// Then
+	assert.Nil(t, err)
+	assert.Equal(t, map[keyedTestUserID]string{42: "john"}, values)
+}
+
+func TestKeyedLoadableGet(t *testing.T) {
+	// Given
+	ctx := context.Background()
+
+	cache1 := New[string](store.NewGoCache(gocache.New(gocache.NoExpiration, time.Minute)))
+
+	loadFunc := func(_ context.Context, id keyedTestUserID) (string, error) {
+		return fmt.Sprintf("user %d", id), nil
+	}
+
+	cache := NewKeyedLoadable[keyedTestUserID, string](loadFunc, cache1, keyedTestUserKey)
+
+	// When
+	value, err := cache.Get(ctx, 42)
+
+	// Then
+	assert.Nil(t, err)
+	assert.Equal(t, "user 42", value)
+
+	assert.Eventually(t, func() bool {
+		cached, err := cache1.Get(ctx, "user:42")
+		return err == nil && cached == "user 42"
+	}, time.Second, 10*time.Millisecond)
+}
+
+func TestKeyedLoadableGetManyWithKeyedLoadManyFunction(t *testing.T) {
+	// Given
+	ctx := context.Background()
+
+	cache1 := New[string](store.NewGoCache(gocache.New(gocache.NoExpiration, time.Minute)))
+
+	var loadedIDs []keyedTestUserID
+	loadManyFunc := func(_ context.Context, ids []keyedTestUserID) (map[keyedTestUserID]string, error) {
+		loadedIDs = ids
+		return map[keyedTestUserID]string{42: "user 42"}, nil
+	}
+
+	cache := NewKeyedLoadable[keyedTestUserID, string](nil, cache1, keyedTestUserKey,
+		WithKeyedLoadManyFunction[keyedTestUserID, string](loadManyFunc),
+	)
+
+	// When
+	values, err := cache.GetMany(ctx, []keyedTestUserID{42, 43})
+
+	// Then
+	assert.Nil(t, err)
+	assert.Equal(t, map[keyedTestUserID]string{42: "user 42"}, values)
+	assert.ElementsMatch(t, []keyedTestUserID{42, 43}, loadedIDs)
+}
+
+func TestKeyedLoadableGetWhenKeyIsNotTyped(t *testing.T) {
+	// Given
+	ctx := context.Background()
+
+	cache1 := New[string](store.NewGoCache(gocache.New(gocache.NoExpiration, time.Minute)))
+
+	loadFunc := func(_ context.Context, id keyedTestUserID) (string, error) {
+		return fmt.Sprintf("user %d", id), nil
+	}
+
+	cache := NewKeyedLoadable[keyedTestUserID, string](loadFunc, cache1, keyedTestUserKey)
+
+	// When
+	_, err := cache.GetCache().Get(ctx, "user:42")
+
+	// Then
+	assert.ErrorIs(t, err, ErrUntypedKey)
+}
+
+func TestKeyedLoadableGetManyWhenKeyIsNotTyped(t *testing.T) {
+	// Given
+	ctx := context.Background()
+
+	cache1 := New[string](store.NewGoCache(gocache.New(gocache.NoExpiration, time.Minute)))
+
+	loadManyFunc := func(_ context.Context, ids []keyedTestUserID) (map[keyedTestUserID]string, error) {
+		return map[keyedTestUserID]string{}, nil
+	}
+
+	cache := NewKeyedLoadable[keyedTestUserID, string](nil, cache1, keyedTestUserKey,
+		WithKeyedLoadManyFunction[keyedTestUserID, string](loadManyFunc),
+	)
+
+	// When
+	_, err := getMany[string](ctx, cache.GetCache(), []any{"user:42"})
+
+	// Then
+	assert.ErrorIs(t, err, ErrUntypedKey)
+}
+
+func TestIntegerKeyEncoder(t *testing.T) {
+	assert.Equal(t, "42", IntegerKeyEncoder(42))
+	assert.Equal(t, "-42", IntegerKeyEncoder(int8(-42)))
+	assert.Equal(t, "18446744073709551615", IntegerKeyEncoder(uint64(18446744073709551615)))
+	assert.Equal(t, "my-key", StringKeyEncoder("my-key"))
+}
@@ -2,43 +2,120 @@ package cache
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"sync"
+	"time"
 
+	"github.com/eko/gocache/v3/lock"
 	"github.com/eko/gocache/v3/store"
 )
 
 const (
 	// LoadableType represents the loadable cache type as a string value
 	LoadableType = "loadable"
+	// LoadableTombstonePattern represents the pattern of the keys used to store tombstones
+	LoadableTombstonePattern = "gocache_tombstone_%s"
 )
 
+// ErrAbsent can be returned (or wrapped) by a load function to notify that
+// the value does not exist in the source, so it can be negatively cached
+var ErrAbsent = errors.New("value is absent from the load function source")
+
+// tombstoneValue is the value stored for keys that are negatively cached
+var tombstoneValue = []byte("1")
+
 type loadableKeyValue[T any] struct {
 	key   any
 	value T
+	// refreshed marks the end of the refresh of the key, once the values
+	// loaded before have been set
+	refreshed bool
 }
 
 type LoadFunction[T any] func(ctx context.Context, key any) (T, error)
 
+// LoadManyFunction loads the values of several keys at once. Keys that do not
+// exist in the source can be omitted from the returned map.
+type LoadManyFunction[T any] func(ctx context.Context, keys []any) (map[any]T, error)
+
+// ttlGetter represents a cache that is able to return the TTL of its values
+type ttlGetter[T any] interface {
+	GetWithTTL(ctx context.Context, key any) (T, time.Duration, error)
+}
+
+// LoadableStats allows to returns some statistics of loadable cache usage
+type LoadableStats struct {
+	Loads        int
+	Coalesced    int
+	StaleHits    int
+	NegativeHits int
+	LockWaits    int
+}
+
 // LoadableCache represents a cache that uses a function to load data
 type LoadableCache[T any] struct {
-	loadFunc   LoadFunction[T]
-	cache      CacheInterface[T]
-	setChannel chan *loadableKeyValue[T]
-	setterWg   *sync.WaitGroup
+	loadFunc       LoadFunction[T]
+	cache          CacheInterface[T]
+	options        *loadableOptions
+	setChannel     chan *loadableKeyValue[T]
+	setterWg       *sync.WaitGroup
+	refreshChannel chan any
+	refreshWg      *sync.WaitGroup
+	refreshing     map[string]struct{}
+	refreshMtx     sync.Mutex
+	loadGroup      *loadGroup[T]
+	keyGenerator   KeyGenerator
+	loadManyFunc   LoadManyFunction[T]
+	loadBatcher    *loadBatcher[T]
+	stats          *LoadableStats
+	statsMtx       sync.Mutex
+	closeMtx       sync.RWMutex
+	closed         bool
 }
 
-// NewLoadable instanciates a new cache that uses a function to load data
-func NewLoadable[T any](loadFunc LoadFunction[T], cache CacheInterface[T]) *LoadableCache[T] {
+// NewLoadable instanciates a new cache that uses a function to load data. It
+// panics when a soft TTL or refresh-ahead ratio is given along with a cache
+// unable to return the TTL of its values, as stale values could never be
+// detected.
+func NewLoadable[T any](loadFunc LoadFunction[T], cache CacheInterface[T], options ...LoadableOption) *LoadableCache[T] {
 	loadable := &LoadableCache[T]{
-		loadFunc:   loadFunc,
-		cache:      cache,
-		setChannel: make(chan *loadableKeyValue[T], 10000),
-		setterWg:   &sync.WaitGroup{},
+		loadFunc:     loadFunc,
+		cache:        cache,
+		options:      applyLoadableOptions(options...),
+		setChannel:   make(chan *loadableKeyValue[T], 10000),
+		setterWg:     &sync.WaitGroup{},
+		refreshWg:    &sync.WaitGroup{},
+		refreshing:   make(map[string]struct{}),
+		loadGroup:    newLoadGroup[T](),
+		keyGenerator: getKeyGenerator(cache),
+		stats:        &LoadableStats{},
+	}
+
+	if loadManyFunc, ok := loadable.options.loadManyFunc.(LoadManyFunction[T]); ok {
+		loadable.loadManyFunc = loadManyFunc
+
+		if loadable.options.batchWindow > 0 {
+			loadable.loadBatcher = newLoadBatcher(loadManyFunc, loadable.keyGenerator, loadable.options.batchWindow, loadable.options.batchMaxSize)
+		}
 	}
 
 	loadable.setterWg.Add(1)
 	go loadable.setter()
 
+	if loadable.options.staleAfter() > 0 {
+		if _, ok := cache.(ttlGetter[T]); !ok {
+			panic(fmt.Sprintf("cache: soft TTL and refresh-ahead require a cache returning TTLs, %s cache does not", cache.GetType()))
+		}
+
+		loadable.refreshChannel = make(chan any, 10000)
+
+		for i := 0; i < loadable.options.refreshWorkers; i++ {
+			loadable.refreshWg.Add(1)
+			go loadable.refresher()
+		}
+	}
+
 	return loadable
 }
 
@@ -46,41 +123,433 @@ func (c *LoadableCache[T]) setter() {
 	defer c.setterWg.Done()
 
 	for item := range c.setChannel {
-		c.Set(context.Background(), item.key, item.value)
+		if item.refreshed {
+			c.endRefresh(item.key)
+			continue
+		}
+
+		c.Set(context.Background(), item.key, item.value, c.setOptions()...)
+	}
+}
+
+// enqueue sends the given loaded value to the set channel, or sets it
+// synchronously once the loadable cache has been closed
+func (c *LoadableCache[T]) enqueue(ctx context.Context, key any, object T) {
+	c.closeMtx.RLock()
+	if !c.closed {
+		c.setChannel <- &loadableKeyValue[T]{key: key, value: object}
+		c.closeMtx.RUnlock()
+		return
+	}
+	c.closeMtx.RUnlock()
+
+	c.Set(ctx, key, object, c.setOptions()...)
+}
+
+// refresher reloads the stale values sent into the refresh channel
+func (c *LoadableCache[T]) refresher() {
+	defer c.refreshWg.Done()
+
+	for key := range c.refreshChannel {
+		ctx := context.Background()
+
+		if _, err := c.load(ctx, key); errors.Is(err, ErrAbsent) {
+			// Value does not exist anymore in the source
+			c.cache.Delete(ctx, key)
+		}
+
+		// The refresh ends once the loaded value has been set, so that reads
+		// in between do not queue it again
+		c.closeMtx.RLock()
+		if !c.closed {
+			c.setChannel <- &loadableKeyValue[T]{key: key, refreshed: true}
+			c.closeMtx.RUnlock()
+			continue
+		}
+		c.closeMtx.RUnlock()
+
+		c.endRefresh(key)
 	}
 }
 
+// endRefresh allows the given key to be refreshed again
+func (c *LoadableCache[T]) endRefresh(key any) {
+	c.refreshMtx.Lock()
+	delete(c.refreshing, c.cacheKey(key))
+	c.refreshMtx.Unlock()
+}
+
+// setOptions returns the store options used to put loaded values back in cache
+func (c *LoadableCache[T]) setOptions() []store.Option {
+	if c.options.hardTTL > 0 {
+		return []store.Option{store.WithExpiration(c.options.hardTTL)}
+	}
+
+	return nil
+}
+
 // Get returns the object stored in cache if it exists
 func (c *LoadableCache[T]) Get(ctx context.Context, key any) (T, error) {
-	var err error
-
-	object, err := c.cache.Get(ctx, key)
+	object, stale, err := c.get(ctx, key)
 	if err == nil {
-		return object, err
+		if stale {
+			// Serve the stale value and reload it in background
+			c.refresh(key)
+		}
+
+		return object, nil
+	}
+
+	if c.isTombstoned(ctx, key) {
+		c.statsMtx.Lock()
+		c.stats.NegativeHits++
+		c.statsMtx.Unlock()
+
+		return *new(T), store.NotFoundWithCause(ErrAbsent)
 	}
 
 	// Unable to find in cache, try to load it from load function
-	object, err = c.loadFunc(ctx, key)
+	return c.load(ctx, key)
+}
+
+// get returns the object stored in cache and whether it has to be refreshed
+func (c *LoadableCache[T]) get(ctx context.Context, key any) (T, bool, error) {
+	staleAfter := c.options.staleAfter()
+
+	cache, ok := c.cache.(ttlGetter[T])
+	if staleAfter == 0 || !ok {
+		object, err := c.cache.Get(ctx, key)
+		return object, false, err
+	}
+
+	object, ttl, err := cache.GetWithTTL(ctx, key)
+	if errors.Is(err, store.ErrUnsupportedOperation) {
+		// Wrapping caches return TTLs only when the cache they wrap does
+		object, err = c.cache.Get(ctx, key)
+		return object, false, err
+	}
+	if err != nil {
+		return object, false, err
+	}
+
+	// Stores that are not able to return a TTL, such as Bigcache, return 0
+	// and values without expiration a negative TTL: consider them as fresh.
+	// The age of a value is derived from its remaining TTL, loaded values
+	// being set with the hard TTL, so a value set with a longer TTL is fresh.
+	return object, ttl > 0 && ttl <= c.options.hardTTL && c.options.hardTTL-ttl >= staleAfter, nil
+}
+
+// refresh queues the given key to be reloaded in background, unless a
+// refresh is already pending for it or the loadable cache has been closed
+func (c *LoadableCache[T]) refresh(key any) {
+	c.statsMtx.Lock()
+	c.stats.StaleHits++
+	c.statsMtx.Unlock()
+
+	c.closeMtx.RLock()
+	defer c.closeMtx.RUnlock()
+
+	if c.closed {
+		return
+	}
+
+	k := c.cacheKey(key)
+
+	c.refreshMtx.Lock()
+	defer c.refreshMtx.Unlock()
+
+	if _, ok := c.refreshing[k]; ok {
+		return
+	}
+
+	select {
+	case c.refreshChannel <- key:
+		c.refreshing[k] = struct{}{}
+	default:
+		// Refresh queue is full, value will be refreshed on a next call
+	}
+}
+
+// load calls the load function for the given key and puts the loaded value
+// back in cache. Concurrent calls for a same key share a single load.
+func (c *LoadableCache[T]) load(ctx context.Context, key any) (T, error) {
+	object, shared, err := c.loadGroup.do(c.cacheKey(key), func() (T, error) {
+		if c.options.locker != nil {
+			return c.loadWithLock(ctx, key)
+		}
+
+		return c.loadAndCache(ctx, key, false)
+	})
+
+	c.statsMtx.Lock()
+	defer c.statsMtx.Unlock()
+	if shared {
+		c.stats.Coalesced++
+	} else {
+		c.stats.Loads++
+	}
+
+	return object, err
+}
+
+// loadAndCache calls the load function for the given key and puts the loaded
+// value back in cache, either synchronously or in background
+func (c *LoadableCache[T]) loadAndCache(ctx context.Context, key any, sync bool) (T, error) {
+	object, err := c.loadFunc(ctx, key)
+	if err != nil && errors.Is(err, ErrAbsent) && c.options.tombstoneStore != nil {
+		c.setTombstone(ctx, key)
+		return object, store.NotFoundWithCause(err)
+	}
 	if err != nil {
 		return object, err
 	}
 
 	// Then, put it back in cache
-	c.setChannel <- &loadableKeyValue[T]{key, object}
+	if sync {
+		c.Set(ctx, key, object, c.setOptions()...)
+	} else {
+		c.enqueue(ctx, key, object)
+	}
 
-	return object, err
+	return object, nil
+}
+
+// loadWithLock loads the given key while holding a distributed lock, so that
+// only one instance loads it at a time. Other instances wait for the value to
+// show up in cache and load it themselves if it does not in time.
+func (c *LoadableCache[T]) loadWithLock(ctx context.Context, key any) (T, error) {
+	l, err := c.options.locker.Acquire(ctx, c.cacheKey(key), c.options.lockTTL)
+	if err == nil {
+		defer l.Release(context.Background())
+
+		// Value may have been loaded by another instance before the lock was acquired
+		if object, err := c.cache.Get(ctx, key); err == nil {
+			return object, nil
+		}
+
+		// Value is cached before the lock is released so waiting instances find it
+		return c.loadAndCache(ctx, key, true)
+	}
+
+	if errors.Is(err, lock.ErrNotAcquired) {
+		object, found, err := c.waitForLoad(ctx, key)
+		if found {
+			c.statsMtx.Lock()
+			c.stats.LockWaits++
+			c.statsMtx.Unlock()
+
+			return object, err
+		}
+		if ctx.Err() != nil {
+			return object, ctx.Err()
+		}
+	}
+
+	// Lock store is unavailable or value did not show up in time: load it anyway
+	return c.loadAndCache(ctx, key, false)
+}
+
+// waitForLoad polls the cache until the value of the given key, or its
+// tombstone, shows up or the load lock wait duration is elapsed
+func (c *LoadableCache[T]) waitForLoad(ctx context.Context, key any) (T, bool, error) {
+	timer := time.NewTimer(c.options.lockWait)
+	defer timer.Stop()
+
+	ticker := time.NewTicker(LoadLockPollInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return *new(T), false, nil
+		case <-timer.C:
+			return *new(T), false, nil
+		case <-ticker.C:
+			if object, err := c.cache.Get(ctx, key); err == nil {
+				return object, true, nil
+			}
+			if c.isTombstoned(ctx, key) {
+				return *new(T), true, store.NotFoundWithCause(ErrAbsent)
+			}
+		}
+	}
+}
+
+// GetMany returns the objects of the given keys that exist, either in cache or
+// in the load functions source. Missing keys are loaded all at once using the
+// load many function when there is one, or one by one otherwise.
+func (c *LoadableCache[T]) GetMany(ctx context.Context, keys []any) (map[any]T, error) {
+	objects := make(map[any]T, len(keys))
+	missingKeys := []any{}
+
+	// Values do not have to be checked for staleness: retrieve them all at once
+	if c.options.staleAfter() == 0 {
+		var err error
+		if objects, err = getMany(ctx, c.cache, keys); err != nil {
+			objects = make(map[any]T, len(keys))
+		}
+	}
+
+	for _, key := range keys {
+		if _, ok := objects[key]; ok {
+			continue
+		}
+
+		if c.options.staleAfter() > 0 {
+			object, stale, err := c.get(ctx, key)
+			if err == nil {
+				if stale {
+					c.refresh(key)
+				}
+
+				objects[key] = object
+				continue
+			}
+		}
+
+		if c.isTombstoned(ctx, key) {
+			c.statsMtx.Lock()
+			c.stats.NegativeHits++
+			c.statsMtx.Unlock()
+
+			continue
+		}
+
+		missingKeys = append(missingKeys, key)
+	}
+
+	if len(missingKeys) == 0 {
+		return objects, nil
+	}
+
+	if c.loadManyFunc == nil {
+		for _, key := range missingKeys {
+			object, err := c.load(ctx, key)
+			if errors.Is(err, ErrAbsent) {
+				continue
+			}
+			if err != nil {
+				return nil, err
+			}
+
+			objects[key] = object
+		}
+
+		return objects, nil
+	}
+
+	loaded, err := c.loadMany(ctx, missingKeys)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, key := range missingKeys {
+		object, ok := loaded[key]
+		if !ok {
+			if c.options.tombstoneStore != nil {
+				c.setTombstone(ctx, key)
+			}
+			continue
+		}
+
+		objects[key] = object
+
+		// Then, put it back in cache
+		c.enqueue(ctx, key, object)
+	}
+
+	return objects, nil
+}
+
+// loadMany calls the load many function for the given keys, batching them with
+// the keys of concurrent calls when a batch window is specified
+func (c *LoadableCache[T]) loadMany(ctx context.Context, keys []any) (map[any]T, error) {
+	if c.loadBatcher == nil {
+		c.statsMtx.Lock()
+		c.stats.Loads++
+		c.statsMtx.Unlock()
+
+		return c.loadManyFunc(ctx, keys)
+	}
+
+	objects, joined, err := c.loadBatcher.load(ctx, keys)
+
+	c.statsMtx.Lock()
+	defer c.statsMtx.Unlock()
+	if joined {
+		c.stats.Coalesced++
+	} else {
+		c.stats.Loads++
+	}
+
+	return objects, err
+}
+
+// tombstoneKey returns the key under which the tombstone of the given key is stored
+func (c *LoadableCache[T]) tombstoneKey(key any) string {
+	return fmt.Sprintf(LoadableTombstonePattern, c.cacheKey(key))
+}
+
+// cacheKey returns the cache key of the given key object using the key
+// generator of the wrapped cache
+func (c *LoadableCache[T]) cacheKey(key any) string {
+	return generateCacheKey(key, c.keyGenerator)
+}
+
+// isTombstoned returns whether the given key is negatively cached
+func (c *LoadableCache[T]) isTombstoned(ctx context.Context, key any) bool {
+	if c.options.tombstoneStore == nil {
+		return false
+	}
+
+	_, err := c.options.tombstoneStore.Get(ctx, c.tombstoneKey(key))
+	return err == nil
+}
+
+// setTombstone marks the given key as negatively cached
+func (c *LoadableCache[T]) setTombstone(ctx context.Context, key any) {
+	c.options.tombstoneStore.Set(ctx, c.tombstoneKey(key), tombstoneValue, store.WithExpiration(c.options.tombstoneTTL))
+}
+
+// deleteTombstone removes the negative cache entry of the given key
+func (c *LoadableCache[T]) deleteTombstone(ctx context.Context, key any) {
+	if c.options.tombstoneStore != nil {
+		c.options.tombstoneStore.Delete(ctx, c.tombstoneKey(key))
+	}
 }
 
 // Set sets a value in available caches
 func (c *LoadableCache[T]) Set(ctx context.Context, key any, object T, options ...store.Option) error {
+	c.deleteTombstone(ctx, key)
+
 	return c.cache.Set(ctx, key, object, options...)
 }
 
 // Delete removes a value from cache
 func (c *LoadableCache[T]) Delete(ctx context.Context, key any) error {
+	c.deleteTombstone(ctx, key)
+
 	return c.cache.Delete(ctx, key)
 }
 
+// SetMany sets values in available caches
+func (c *LoadableCache[T]) SetMany(ctx context.Context, items map[any]T, options ...store.Option) error {
+	for key := range items {
+		c.deleteTombstone(ctx, key)
+	}
+
+	return setMany(ctx, c.cache, items, options...)
+}
+
+// DeleteMany removes values from cache
+func (c *LoadableCache[T]) DeleteMany(ctx context.Context, keys []any) error {
+	for _, key := range keys {
+		c.deleteTombstone(ctx, key)
+	}
+
+	return deleteMany(ctx, c.cache, keys)
+}
+
 // Invalidate invalidates cache item from given options
 func (c *LoadableCache[T]) Invalidate(ctx context.Context, options ...store.InvalidateOption) error {
 	return c.cache.Invalidate(ctx, options...)
@@ -91,13 +560,89 @@ func (c *LoadableCache[T]) Clear(ctx context.Context) error {
 	return c.cache.Clear(ctx)
 }
 
+// Increment atomically increments a counter of the wrapped cache, when
+// supported. Counters are neither loaded nor negatively cached.
+func (c *LoadableCache[T]) Increment(ctx context.Context, key any, delta int64, options ...store.Option) (int64, error) {
+	counterCache, ok := c.cache.(CounterCacheInterface)
+	if !ok {
+		return 0, store.ErrUnsupportedOperation
+	}
+
+	return counterCache.Increment(ctx, key, delta, options...)
+}
+
+// Decrement atomically decrements a counter of the wrapped cache, when
+// supported
+func (c *LoadableCache[T]) Decrement(ctx context.Context, key any, delta int64, options ...store.Option) (int64, error) {
+	return c.Increment(ctx, key, -delta, options...)
+}
+
+// GetCounter obtains a counter of the wrapped cache without changing it, when
+// supported
+func (c *LoadableCache[T]) GetCounter(ctx context.Context, key any) (int64, error) {
+	counterCache, ok := c.cache.(CounterCacheInterface)
+	if !ok {
+		return 0, store.ErrUnsupportedOperation
+	}
+
+	return counterCache.GetCounter(ctx, key)
+}
+
+// SetCounterIfNotExists initializes a counter of the wrapped cache only when
+// it does not exist yet, when supported
+func (c *LoadableCache[T]) SetCounterIfNotExists(ctx context.Context, key any, counter int64, options ...store.Option) error {
+	counterCache, ok := c.cache.(CounterCacheInterface)
+	if !ok {
+		return store.ErrUnsupportedOperation
+	}
+
+	return counterCache.SetCounterIfNotExists(ctx, key, counter, options...)
+}
+
+// GetStats returns some statistics about the loads done by this cache
+func (c *LoadableCache[T]) GetStats() *LoadableStats {
+	c.statsMtx.Lock()
+	defer c.statsMtx.Unlock()
+	stats := *c.stats
+	return &stats
+}
+
 // GetType returns the cache type
 func (c *LoadableCache[T]) GetType() string {
 	return LoadableType
 }
 
+// GetKeyGenerator returns the key generator of the wrapped cache
+func (c *LoadableCache[T]) GetKeyGenerator() KeyGenerator {
+	return c.keyGenerator
+}
+
+// GetComponents returns the wrapped cache
+func (c *LoadableCache[T]) GetComponents() []any {
+	return []any{c.cache}
+}
+
+// Close sets the loaded values queued to be set in background and stops the
+// goroutines setting and refreshing them. Values loaded afterwards are set
+// synchronously and stale values are not refreshed anymore. The wrapped cache
+// is not closed, see CloseAll.
 func (c *LoadableCache[T]) Close() error {
+	c.closeMtx.Lock()
+	if c.closed {
+		c.closeMtx.Unlock()
+		return nil
+	}
+	c.closed = true
+
+	if c.refreshChannel != nil {
+		close(c.refreshChannel)
+	}
 	close(c.setChannel)
+	c.closeMtx.Unlock()
+
+	// Refreshers set the values they load synchronously from now on, so
+	// that they are waited for without holding the lock
+	c.refreshWg.Wait()
 	c.setterWg.Wait()
 
 	return nil
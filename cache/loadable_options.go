@@ -0,0 +1,151 @@
+package cache
+
+import (
+	"time"
+
+	"github.com/eko/gocache/v3/lock"
+	"github.com/eko/gocache/v3/store"
+)
+
+const (
+	// DefaultRefreshWorkers represents the default number of goroutines
+	// refreshing stale values in background
+	DefaultRefreshWorkers = 4
+	// LoadLockPollInterval represents the interval at which the cache is checked
+	// while waiting for a value loaded by another instance
+	LoadLockPollInterval = 50 * time.Millisecond
+)
+
+// LoadableOption represents a loadable cache option function.
+type LoadableOption func(o *loadableOptions)
+
+type loadableOptions struct {
+	softTTL        time.Duration
+	hardTTL        time.Duration
+	refreshAhead   float64
+	refreshWorkers int
+	tombstoneStore store.StoreInterface
+	tombstoneTTL   time.Duration
+	loadManyFunc   any
+	batchWindow    time.Duration
+	batchMaxSize   int
+	locker         lock.LockerInterface
+	lockTTL        time.Duration
+	lockWait       time.Duration
+}
+
+// staleAfter returns the age after which a cached value has to be
+// refreshed in background or 0 if values are never refreshed
+func (o *loadableOptions) staleAfter() time.Duration {
+	if o.hardTTL <= 0 {
+		return 0
+	}
+
+	if o.softTTL > 0 {
+		return o.softTTL
+	}
+
+	if o.refreshAhead > 0 && o.refreshAhead < 1 {
+		return time.Duration(float64(o.hardTTL) * o.refreshAhead)
+	}
+
+	return 0
+}
+
+func applyLoadableOptions(opts ...LoadableOption) *loadableOptions {
+	o := &loadableOptions{
+		refreshWorkers: DefaultRefreshWorkers,
+	}
+
+	for _, opt := range opts {
+		opt(o)
+	}
+
+	return o
+}
+
+// WithHardTTL allows to specify the expiration time used when loaded values
+// are put back in cache. Values are not available anymore after this duration.
+func WithHardTTL(ttl time.Duration) LoadableOption {
+	return func(o *loadableOptions) {
+		o.hardTTL = ttl
+	}
+}
+
+// WithSoftTTL allows to specify the age after which a cached value is
+// considered as stale: it is still returned but reloaded in background.
+// It requires a hard TTL to be set and the underlying cache to return TTLs,
+// NewLoadable panicking otherwise. The age of a value is derived from its
+// remaining TTL, as loaded values are set with the hard TTL, so values set
+// with a shorter expiration are considered as older. Values of stores unable
+// to return a TTL, such as Bigcache, are never considered as stale.
+func WithSoftTTL(ttl time.Duration) LoadableOption {
+	return func(o *loadableOptions) {
+		o.softTTL = ttl
+	}
+}
+
+// WithRefreshAhead allows to refresh cached values in background once the
+// given ratio of their hard TTL has passed (for instance 0.8 for 80%).
+// It is ignored when a soft TTL is specified.
+func WithRefreshAhead(ratio float64) LoadableOption {
+	return func(o *loadableOptions) {
+		o.refreshAhead = ratio
+	}
+}
+
+// WithRefreshWorkers allows to specify the number of goroutines refreshing
+// stale values in background. At least one goroutine is started, so that
+// stale values are always refreshed.
+func WithRefreshWorkers(workers int) LoadableOption {
+	return func(o *loadableOptions) {
+		if workers < 1 {
+			workers = 1
+		}
+		o.refreshWorkers = workers
+	}
+}
+
+// WithNegativeCaching allows to remember that a value does not exist when the
+// load function returns ErrAbsent: a tombstone is stored in the given store for
+// the given duration and store.NotFound is returned without calling the load
+// function again until it expires.
+func WithNegativeCaching(tombstoneStore store.StoreInterface, ttl time.Duration) LoadableOption {
+	return func(o *loadableOptions) {
+		o.tombstoneStore = tombstoneStore
+		o.tombstoneTTL = ttl
+	}
+}
+
+// WithLoadManyFunction allows to specify a function used by GetMany to load
+// all the missing keys at once. Its type parameter must match the one of the
+// loadable cache, otherwise it is ignored.
+func WithLoadManyFunction[T any](loadManyFunc LoadManyFunction[T]) LoadableOption {
+	return func(o *loadableOptions) {
+		o.loadManyFunc = loadManyFunc
+	}
+}
+
+// WithBatchWindow allows to collect the keys missed by concurrent GetMany
+// calls during the given window into a single call to the load many function.
+// A batch is loaded before the end of the window once it reaches maxSize keys
+// (0 means no limit).
+func WithBatchWindow(window time.Duration, maxSize int) LoadableOption {
+	return func(o *loadableOptions) {
+		o.batchWindow = window
+		o.batchMaxSize = maxSize
+	}
+}
+
+// WithLoadLock allows to take a distributed lock of the given duration before
+// calling the load function, so that only one instance loads a same key at a
+// time. Other instances wait up to maxWait for the value to show up in cache
+// before loading it themselves. The lock duration should exceed the time taken
+// by the load function.
+func WithLoadLock(locker lock.LockerInterface, ttl time.Duration, maxWait time.Duration) LoadableOption {
+	return func(o *loadableOptions) {
+		o.locker = locker
+		o.lockTTL = ttl
+		o.lockWait = maxWait
+	}
+}
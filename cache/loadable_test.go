@@ -3,11 +3,18 @@ package cache
 import (
 	"context"
 	"errors"
+	"fmt"
+	"sync"
+	"sync/atomic"
 	"testing"
 	"time"
 
+	"github.com/coocood/freecache"
+	"github.com/eko/gocache/v3/lock"
 	"github.com/eko/gocache/v3/store"
 	mocksCache "github.com/eko/gocache/v3/test/mocks/cache"
+	mocksLock "github.com/eko/gocache/v3/test/mocks/lock"
+	mocksMetrics "github.com/eko/gocache/v3/test/mocks/metrics"
 	"github.com/golang/mock/gomock"
 	gocache "github.com/patrickmn/go-cache"
 	"github.com/stretchr/testify/assert"
@@ -122,6 +129,643 @@ func TestLoadableGetWhenAvailableInLoadFunc(t *testing.T) {
 	assert.Equal(t, cacheValue, value)
 }
 
+func TestLoadableGetWhenConcurrentMisses(t *testing.T) {
+	// Given
+	ctrl := gomock.NewController(t)
+
+	ctx := context.Background()
+
+	cacheValue := &struct {
+		Hello string
+	}{
+		Hello: "world",
+	}
+
+	callers := 50
+
+	cache1 := mocksCache.NewMockSetterCacheInterface[any](ctrl)
+	cache1.EXPECT().Get(ctx, "my-key").Times(callers).Return(nil, errors.New("unable to find in cache 1"))
+	cache1.EXPECT().Set(gomock.Any(), "my-key", cacheValue).AnyTimes().Return(nil)
+
+	var loads int32
+	release := make(chan struct{})
+
+	loadFunc := func(_ context.Context, key any) (any, error) {
+		atomic.AddInt32(&loads, 1)
+		<-release
+		return cacheValue, nil
+	}
+
+	cache := NewLoadable[any](loadFunc, cache1)
+
+	// When
+	var wg sync.WaitGroup
+	for i := 0; i < callers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			value, err := cache.Get(ctx, "my-key")
+			assert.Nil(t, err)
+			assert.Equal(t, cacheValue, value)
+		}()
+	}
+
+	// Wait for all the callers to join the in-flight load
+	for {
+		cache.loadGroup.mu.Lock()
+		call, ok := cache.loadGroup.calls["my-key"]
+		joined := ok && call.dups == callers-1
+		cache.loadGroup.mu.Unlock()
+
+		if joined {
+			break
+		}
+		time.Sleep(1 * time.Millisecond)
+	}
+
+	close(release)
+	wg.Wait()
+
+	// Then
+	assert.Equal(t, int32(1), atomic.LoadInt32(&loads))
+	assert.Equal(t, 1, cache.GetStats().Loads)
+	assert.Equal(t, callers-1, cache.GetStats().Coalesced)
+}
+
+func TestLoadableGetWhenStale(t *testing.T) {
+	// Given
+	ctrl := gomock.NewController(t)
+
+	ctx := context.Background()
+
+	cache1 := mocksCache.NewMockSetterCacheInterface[any](ctrl)
+	cache1.EXPECT().GetWithTTL(ctx, "my-key").Return("old value", 2*time.Second, nil)
+
+	refreshed := make(chan struct{})
+	cache1.EXPECT().Set(gomock.Any(), "my-key", "new value", store.OptionsMatcher{
+		Expiration: 10 * time.Second,
+	}).Do(func(_ context.Context, _ any, _ any, _ ...store.Option) {
+		close(refreshed)
+	}).Return(nil)
+
+	loadFunc := func(_ context.Context, key any) (any, error) {
+		return "new value", nil
+	}
+
+	cache := NewLoadable[any](loadFunc, cache1, WithHardTTL(10*time.Second), WithSoftTTL(5*time.Second))
+
+	// When
+	value, err := cache.Get(ctx, "my-key")
+
+	// Then
+	assert.Nil(t, err)
+	assert.Equal(t, "old value", value)
+
+	select {
+	case <-refreshed:
+	case <-time.After(1 * time.Second):
+		t.Fatal("stale value has not been refreshed")
+	}
+
+	assert.Equal(t, 1, cache.GetStats().StaleHits)
+	assert.Equal(t, 1, cache.GetStats().Loads)
+}
+
+func TestLoadableGetWhenStaleDuringRefreshSet(t *testing.T) {
+	// Given
+	ctrl := gomock.NewController(t)
+
+	ctx := context.Background()
+
+	cache1 := mocksCache.NewMockSetterCacheInterface[any](ctrl)
+	cache1.EXPECT().GetWithTTL(ctx, "my-key").Return("old value", 2*time.Second, nil).Times(2)
+
+	setting := make(chan struct{})
+	release := make(chan struct{})
+	cache1.EXPECT().Set(gomock.Any(), "my-key", "new value", gomock.Any()).Do(func(_ context.Context, _ any, _ any, _ ...store.Option) {
+		close(setting)
+		<-release
+	}).Return(nil)
+
+	loadFunc := func(_ context.Context, key any) (any, error) {
+		return "new value", nil
+	}
+
+	cache := NewLoadable[any](loadFunc, cache1, WithHardTTL(10*time.Second), WithSoftTTL(5*time.Second))
+
+	_, err := cache.Get(ctx, "my-key")
+	assert.Nil(t, err)
+
+	select {
+	case <-setting:
+	case <-time.After(1 * time.Second):
+		t.Fatal("stale value has not been refreshed")
+	}
+
+	// When
+	value, err := cache.Get(ctx, "my-key")
+
+	// Then
+	assert.Nil(t, err)
+	assert.Equal(t, "old value", value)
+
+	assert.Never(t, func() bool {
+		return cache.GetStats().Loads > 1
+	}, 100*time.Millisecond, 5*time.Millisecond)
+
+	close(release)
+	assert.Nil(t, cache.Close())
+
+	assert.Equal(t, 2, cache.GetStats().StaleHits)
+	assert.Equal(t, 1, cache.GetStats().Loads)
+	assert.Empty(t, cache.refreshing)
+}
+
+func TestLoadableGetWhenStaleWithoutRefreshWorkers(t *testing.T) {
+	// Given
+	ctrl := gomock.NewController(t)
+
+	ctx := context.Background()
+
+	cache1 := mocksCache.NewMockSetterCacheInterface[any](ctrl)
+	cache1.EXPECT().GetWithTTL(ctx, "my-key").Return("old value", 2*time.Second, nil)
+
+	refreshed := make(chan struct{})
+	cache1.EXPECT().Set(gomock.Any(), "my-key", "new value", gomock.Any()).Do(func(_ context.Context, _ any, _ any, _ ...store.Option) {
+		close(refreshed)
+	}).Return(nil)
+
+	loadFunc := func(_ context.Context, key any) (any, error) {
+		return "new value", nil
+	}
+
+	cache := NewLoadable[any](loadFunc, cache1, WithHardTTL(10*time.Second), WithSoftTTL(5*time.Second), WithRefreshWorkers(0))
+
+	// When
+	value, err := cache.Get(ctx, "my-key")
+
+	// Then
+	assert.Nil(t, err)
+	assert.Equal(t, "old value", value)
+
+	select {
+	case <-refreshed:
+	case <-time.After(1 * time.Second):
+		t.Fatal("stale value has not been refreshed")
+	}
+}
+
+func TestLoadableGetWhenStaleInChain(t *testing.T) {
+	// Given
+	ctx := context.Background()
+
+	cache1 := New[any](store.NewGoCache(gocache.New(gocache.NoExpiration, time.Minute)))
+	cache2 := New[any](store.NewGoCache(gocache.New(gocache.NoExpiration, time.Minute)))
+
+	chain := NewChainWithOptions[any]([]SetterCacheInterface[any]{cache1, cache2},
+		WithBackfillPolicy(BackfillSync),
+	)
+	defer chain.Close()
+
+	err := chain.Set(ctx, "my-key", "old value", store.WithExpiration(2*time.Second))
+	assert.Nil(t, err)
+
+	loadFunc := func(_ context.Context, key any) (any, error) {
+		return "new value", nil
+	}
+
+	metrics := mocksMetrics.NewMockMetricsInterface(gomock.NewController(t))
+	metrics.EXPECT().RecordFromCodec(gomock.Any()).AnyTimes()
+
+	cache := NewLoadable[any](loadFunc, NewMetric[any](metrics, chain),
+		WithHardTTL(10*time.Second), WithSoftTTL(5*time.Second),
+	)
+	defer cache.Close()
+
+	// When
+	value, err := cache.Get(ctx, "my-key")
+
+	// Then
+	assert.Nil(t, err)
+	assert.Equal(t, "old value", value)
+
+	assert.Eventually(t, func() bool {
+		value, _ := chain.Get(ctx, "my-key")
+		return value == "new value"
+	}, time.Second, 10*time.Millisecond)
+
+	assert.Equal(t, 1, cache.GetStats().StaleHits)
+}
+
+func TestLoadableGetWhenStaleWithoutTTL(t *testing.T) {
+	// Given
+	ctrl := gomock.NewController(t)
+
+	cache1 := mocksCache.NewMockCacheInterface[any](ctrl)
+	cache1.EXPECT().GetType().Return("custom")
+
+	loadFunc := func(_ context.Context, key any) (any, error) {
+		return "new value", nil
+	}
+
+	// When - Then
+	assert.PanicsWithValue(t, "cache: soft TTL and refresh-ahead require a cache returning TTLs, custom cache does not", func() {
+		NewLoadable[any](loadFunc, cache1, WithHardTTL(10*time.Second), WithSoftTTL(5*time.Second))
+	})
+}
+
+func TestLoadableGetWhenNotStaleWithRefreshAhead(t *testing.T) {
+	// Given
+	ctrl := gomock.NewController(t)
+
+	ctx := context.Background()
+
+	cache1 := mocksCache.NewMockSetterCacheInterface[any](ctrl)
+	cache1.EXPECT().GetWithTTL(ctx, "my-key").Return("my value", 3*time.Second, nil)
+
+	loadFunc := func(_ context.Context, key any) (any, error) {
+		return nil, errors.New("should not be called")
+	}
+
+	cache := NewLoadable[any](loadFunc, cache1, WithHardTTL(10*time.Second), WithRefreshAhead(0.8))
+
+	// When
+	value, err := cache.Get(ctx, "my-key")
+
+	// Then
+	assert.Nil(t, err)
+	assert.Equal(t, "my value", value)
+	assert.Equal(t, 0, cache.GetStats().StaleHits)
+}
+
+func TestLoadableGetWhenMissUsesHardTTL(t *testing.T) {
+	// Given
+	ctrl := gomock.NewController(t)
+
+	ctx := context.Background()
+
+	cache1 := mocksCache.NewMockSetterCacheInterface[any](ctrl)
+	cache1.EXPECT().GetWithTTL(ctx, "my-key").Return(nil, 0*time.Second, errors.New("unable to find in cache 1"))
+
+	stored := make(chan struct{})
+	cache1.EXPECT().Set(gomock.Any(), "my-key", "my value", store.OptionsMatcher{
+		Expiration: 10 * time.Second,
+	}).Do(func(_ context.Context, _ any, _ any, _ ...store.Option) {
+		close(stored)
+	}).Return(nil)
+
+	loadFunc := func(_ context.Context, key any) (any, error) {
+		return "my value", nil
+	}
+
+	cache := NewLoadable[any](loadFunc, cache1, WithHardTTL(10*time.Second), WithRefreshAhead(0.8))
+
+	// When
+	value, err := cache.Get(ctx, "my-key")
+
+	// Then
+	assert.Nil(t, err)
+	assert.Equal(t, "my value", value)
+
+	select {
+	case <-stored:
+	case <-time.After(1 * time.Second):
+		t.Fatal("loaded value has not been put back in cache")
+	}
+}
+
+func TestLoadableGetWhenNegativelyCached(t *testing.T) {
+	// Given
+	ctrl := gomock.NewController(t)
+
+	ctx := context.Background()
+
+	cache1 := mocksCache.NewMockSetterCacheInterface[any](ctrl)
+	cache1.EXPECT().Get(ctx, "my-key").Times(2).Return(nil, errors.New("unable to find in cache 1"))
+
+	tombstoneStore := store.NewFreecache(freecache.NewCache(1024 * 1024))
+
+	var loads int32
+	loadFunc := func(_ context.Context, key any) (any, error) {
+		atomic.AddInt32(&loads, 1)
+		return nil, fmt.Errorf("unable to find book %v: %w", key, ErrAbsent)
+	}
+
+	cache := NewLoadable[any](loadFunc, cache1, WithNegativeCaching(tombstoneStore, 1*time.Minute))
+
+	// When
+	_, firstErr := cache.Get(ctx, "my-key")
+	_, secondErr := cache.Get(ctx, "my-key")
+
+	// Then
+	assert.True(t, errors.Is(firstErr, &store.NotFound{}))
+	assert.True(t, errors.Is(firstErr, ErrAbsent))
+	assert.True(t, errors.Is(secondErr, &store.NotFound{}))
+
+	assert.Equal(t, int32(1), atomic.LoadInt32(&loads))
+	assert.Equal(t, 1, cache.GetStats().NegativeHits)
+
+	_, err := tombstoneStore.Get(ctx, "gocache_tombstone_my-key")
+	assert.Nil(t, err)
+}
+
+func TestLoadableGetWhenNegativelyCachedWithKeyGenerator(t *testing.T) {
+	// Given
+	ctx := context.Background()
+
+	type bookKey struct {
+		ID int
+	}
+
+	cache1 := New[any](store.NewFreecache(freecache.NewCache(1024*1024)), WithKeyGenerator(ReadableKeyGenerator))
+
+	tombstoneStore := store.NewFreecache(freecache.NewCache(1024 * 1024))
+
+	loadFunc := func(_ context.Context, key any) (any, error) {
+		return nil, fmt.Errorf("unable to find book %v: %w", key, ErrAbsent)
+	}
+
+	cache := NewLoadable[any](loadFunc, cache1, WithNegativeCaching(tombstoneStore, 1*time.Minute))
+
+	// When
+	_, err := cache.Get(ctx, bookKey{ID: 42})
+
+	// Then
+	assert.True(t, errors.Is(err, ErrAbsent))
+
+	_, err = tombstoneStore.Get(ctx, "gocache_tombstone_bookKey:ID=42")
+	assert.Nil(t, err)
+}
+
+func TestLoadableSetWhenNegativelyCached(t *testing.T) {
+	// Given
+	ctrl := gomock.NewController(t)
+
+	ctx := context.Background()
+
+	cache1 := mocksCache.NewMockSetterCacheInterface[any](ctrl)
+	cache1.EXPECT().Set(ctx, "my-key", "my value").Return(nil)
+
+	tombstoneStore := store.NewFreecache(freecache.NewCache(1024 * 1024))
+	tombstoneStore.Set(ctx, "gocache_tombstone_my-key", []byte("1"))
+
+	loadFunc := func(_ context.Context, key any) (any, error) {
+		return nil, ErrAbsent
+	}
+
+	cache := NewLoadable[any](loadFunc, cache1, WithNegativeCaching(tombstoneStore, 1*time.Minute))
+
+	// When
+	err := cache.Set(ctx, "my-key", "my value")
+
+	// Then
+	assert.Nil(t, err)
+
+	_, err = tombstoneStore.Get(ctx, "gocache_tombstone_my-key")
+	assert.True(t, errors.Is(err, &store.NotFound{}))
+}
+
+func TestLoadableGetWithLoadLock(t *testing.T) {
+	// Given
+	ctrl := gomock.NewController(t)
+
+	ctx := context.Background()
+
+	cache1 := mocksCache.NewMockSetterCacheInterface[any](ctrl)
+	cache1.EXPECT().Get(ctx, "my-key").Times(2).Return(nil, errors.New("unable to find in cache 1"))
+	cache1.EXPECT().Set(ctx, "my-key", "my-value").Return(nil)
+
+	l := mocksLock.NewMockLockInterface(ctrl)
+	l.EXPECT().Release(gomock.Any()).Return(nil)
+
+	locker := mocksLock.NewMockLockerInterface(ctrl)
+	locker.EXPECT().Acquire(ctx, "my-key", 5*time.Second).Return(l, nil)
+
+	loadFunc := func(_ context.Context, key any) (any, error) {
+		return "my-value", nil
+	}
+
+	cache := NewLoadable[any](loadFunc, cache1, WithLoadLock(locker, 5*time.Second, time.Second))
+
+	// When
+	value, err := cache.Get(ctx, "my-key")
+
+	// Then
+	assert.Nil(t, err)
+	assert.Equal(t, "my-value", value)
+	assert.Equal(t, 0, len(cache.setChannel))
+}
+
+func TestLoadableGetWithLoadLockWhenHeldByAnotherInstance(t *testing.T) {
+	// Given
+	ctrl := gomock.NewController(t)
+
+	ctx := context.Background()
+
+	cache1 := mocksCache.NewMockSetterCacheInterface[any](ctrl)
+	gomock.InOrder(
+		cache1.EXPECT().Get(ctx, "my-key").Times(2).Return(nil, errors.New("unable to find in cache 1")),
+		cache1.EXPECT().Get(ctx, "my-key").Return("my-value", nil),
+	)
+
+	locker := mocksLock.NewMockLockerInterface(ctrl)
+	locker.EXPECT().Acquire(ctx, "my-key", 5*time.Second).Return(nil, lock.ErrNotAcquired)
+
+	loadFunc := func(_ context.Context, key any) (any, error) {
+		return nil, errors.New("should not be called")
+	}
+
+	cache := NewLoadable[any](loadFunc, cache1, WithLoadLock(locker, 5*time.Second, time.Second))
+
+	// When
+	value, err := cache.Get(ctx, "my-key")
+
+	// Then
+	assert.Nil(t, err)
+	assert.Equal(t, "my-value", value)
+	assert.Equal(t, 1, cache.GetStats().LockWaits)
+}
+
+func TestLoadableGetWithLoadLockWhenWaitElapsed(t *testing.T) {
+	// Given
+	ctrl := gomock.NewController(t)
+
+	ctx := context.Background()
+
+	cache1 := mocksCache.NewMockSetterCacheInterface[any](ctrl)
+	cache1.EXPECT().Get(ctx, "my-key").MinTimes(2).Return(nil, errors.New("unable to find in cache 1"))
+	cache1.EXPECT().Set(gomock.Any(), "my-key", "my-value").AnyTimes().Return(nil)
+
+	locker := mocksLock.NewMockLockerInterface(ctrl)
+	locker.EXPECT().Acquire(ctx, "my-key", 5*time.Second).Return(nil, lock.ErrNotAcquired)
+
+	loadFunc := func(_ context.Context, key any) (any, error) {
+		return "my-value", nil
+	}
+
+	cache := NewLoadable[any](loadFunc, cache1, WithLoadLock(locker, 5*time.Second, 3*LoadLockPollInterval))
+
+	// When
+	value, err := cache.Get(ctx, "my-key")
+
+	// Then
+	assert.Nil(t, err)
+	assert.Equal(t, "my-value", value)
+	assert.Equal(t, 0, cache.GetStats().LockWaits)
+	assert.Equal(t, 1, cache.GetStats().Loads)
+}
+
+func TestLoadableGetWithLoadLockWhenSharedByInstances(t *testing.T) {
+	// Given
+	gocacheStore := store.NewGoCache(gocache.New(5*time.Second, 5*time.Second), store.WithExpiration(5*time.Second))
+	locker := lock.New(gocacheStore)
+
+	var loads int32
+	loadFunc := func(_ context.Context, key any) (string, error) {
+		atomic.AddInt32(&loads, 1)
+		time.Sleep(2 * LoadLockPollInterval)
+		return "my-value", nil
+	}
+
+	instances := 5
+
+	// When
+	var wg sync.WaitGroup
+	for i := 0; i < instances; i++ {
+		cache := NewLoadable[string](loadFunc, New[string](gocacheStore), WithLoadLock(locker, 5*time.Second, time.Second))
+
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			value, err := cache.Get(context.Background(), "my-key")
+			assert.Nil(t, err)
+			assert.Equal(t, "my-value", value)
+		}()
+	}
+	wg.Wait()
+
+	// Then
+	assert.Equal(t, int32(1), atomic.LoadInt32(&loads))
+}
+
+func TestLoadableGetMany(t *testing.T) {
+	// Given
+	ctrl := gomock.NewController(t)
+
+	ctx := context.Background()
+
+	cache1 := mocksCache.NewMockSetterCacheInterface[any](ctrl)
+	cache1.EXPECT().Get(ctx, "key-1").Return("value 1", nil)
+	cache1.EXPECT().Get(ctx, "key-2").Return(nil, errors.New("unable to find in cache 1"))
+	cache1.EXPECT().Get(ctx, "key-3").Return(nil, errors.New("unable to find in cache 1"))
+	cache1.EXPECT().Set(gomock.Any(), "key-2", "value 2").AnyTimes().Return(nil)
+
+	loadFunc := func(_ context.Context, key any) (any, error) {
+		return nil, errors.New("should not be called")
+	}
+
+	var loadedKeys []any
+	loadManyFunc := func(_ context.Context, keys []any) (map[any]any, error) {
+		loadedKeys = keys
+		return map[any]any{"key-2": "value 2"}, nil
+	}
+
+	cache := NewLoadable[any](loadFunc, cache1, WithLoadManyFunction[any](loadManyFunc))
+
+	// When
+	values, err := cache.GetMany(ctx, []any{"key-1", "key-2", "key-3"})
+
+	// Then
+	assert.Nil(t, err)
+	assert.Equal(t, map[any]any{"key-1": "value 1", "key-2": "value 2"}, values)
+	assert.Equal(t, []any{"key-2", "key-3"}, loadedKeys)
+	assert.Equal(t, 1, cache.GetStats().Loads)
+}
+
+func TestLoadableGetManyWhenError(t *testing.T) {
+	// Given
+	ctrl := gomock.NewController(t)
+
+	ctx := context.Background()
+
+	expectedErr := errors.New("unable to load data from custom source")
+
+	cache1 := mocksCache.NewMockSetterCacheInterface[any](ctrl)
+	cache1.EXPECT().Get(ctx, "key-1").Return(nil, errors.New("unable to find in cache 1"))
+
+	loadFunc := func(_ context.Context, key any) (any, error) {
+		return nil, errors.New("should not be called")
+	}
+
+	loadManyFunc := func(_ context.Context, keys []any) (map[any]any, error) {
+		return nil, expectedErr
+	}
+
+	cache := NewLoadable[any](loadFunc, cache1, WithLoadManyFunction[any](loadManyFunc))
+
+	// When
+	values, err := cache.GetMany(ctx, []any{"key-1"})
+
+	// Then
+	assert.Nil(t, values)
+	assert.Equal(t, expectedErr, err)
+}
+
+func TestLoadableGetManyWithBatchWindow(t *testing.T) {
+	// Given
+	ctrl := gomock.NewController(t)
+
+	ctx := context.Background()
+
+	cache1 := mocksCache.NewMockSetterCacheInterface[any](ctrl)
+	cache1.EXPECT().Get(ctx, gomock.Any()).AnyTimes().Return(nil, errors.New("unable to find in cache 1"))
+	cache1.EXPECT().Set(gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes().Return(nil)
+
+	loadFunc := func(_ context.Context, key any) (any, error) {
+		return nil, errors.New("should not be called")
+	}
+
+	var calls int32
+	loadManyFunc := func(_ context.Context, keys []any) (map[any]any, error) {
+		atomic.AddInt32(&calls, 1)
+
+		values := map[any]any{}
+		for _, key := range keys {
+			values[key] = fmt.Sprintf("value of %v", key)
+		}
+		return values, nil
+	}
+
+	cache := NewLoadable[any](loadFunc, cache1,
+		WithLoadManyFunction[any](loadManyFunc),
+		WithBatchWindow(50*time.Millisecond, 0),
+	)
+
+	// When
+	var wg sync.WaitGroup
+	for _, keys := range [][]any{{"key-1", "key-2"}, {"key-2", "key-3"}} {
+		wg.Add(1)
+		go func(keys []any) {
+			defer wg.Done()
+
+			values, err := cache.GetMany(ctx, keys)
+			assert.Nil(t, err)
+			assert.Len(t, values, 2)
+			for _, key := range keys {
+				assert.Equal(t, fmt.Sprintf("value of %v", key), values[key])
+			}
+		}(keys)
+	}
+	wg.Wait()
+
+	// Then
+	assert.Equal(t, int32(1), atomic.LoadInt32(&calls))
+	assert.Equal(t, 1, cache.GetStats().Loads)
+	assert.Equal(t, 1, cache.GetStats().Coalesced)
+}
+
 func TestLoadableDelete(t *testing.T) {
 	// Given
 	ctrl := gomock.NewController(t)
@@ -260,6 +904,55 @@ func TestLoadableClearWhenError(t *testing.T) {
 	assert.Equal(t, expectedErr, err)
 }
 
+func TestLoadableCloseTwice(t *testing.T) {
+	// Given
+	ctrl := gomock.NewController(t)
+
+	cache1 := mocksCache.NewMockSetterCacheInterface[any](ctrl)
+
+	loadFunc := func(_ context.Context, key any) (any, error) {
+		return "a value", nil
+	}
+
+	cache := NewLoadable[any](loadFunc, cache1, WithHardTTL(10*time.Second), WithSoftTTL(5*time.Second))
+
+	// When - Then
+	assert.Nil(t, cache.Close())
+	assert.Nil(t, cache.Close())
+}
+
+func TestLoadableGetAfterClose(t *testing.T) {
+	// Given
+	ctrl := gomock.NewController(t)
+
+	ctx := context.Background()
+
+	cache1 := mocksCache.NewMockSetterCacheInterface[any](ctrl)
+	cache1.EXPECT().GetWithTTL(ctx, "my-key").Return(nil, 0*time.Second, errors.New("unable to find in cache 1"))
+	cache1.EXPECT().GetWithTTL(ctx, "stale-key").Return("old value", 2*time.Second, nil)
+	cache1.EXPECT().Set(ctx, "my-key", "a value", store.OptionsMatcher{
+		Expiration: 10 * time.Second,
+	}).Return(nil)
+
+	loadFunc := func(_ context.Context, key any) (any, error) {
+		return "a value", nil
+	}
+
+	cache := NewLoadable[any](loadFunc, cache1, WithHardTTL(10*time.Second), WithSoftTTL(5*time.Second))
+	assert.Nil(t, cache.Close())
+
+	// When
+	value, err := cache.Get(ctx, "my-key")
+	staleValue, staleErr := cache.Get(ctx, "stale-key")
+
+	// Then
+	// The loaded value is set synchronously and the stale one is not refreshed
+	assert.Nil(t, err)
+	assert.Equal(t, "a value", value)
+	assert.Nil(t, staleErr)
+	assert.Equal(t, "old value", staleValue)
+}
+
 func TestLoadableGetType(t *testing.T) {
 	// Given
 	ctrl := gomock.NewController(t)
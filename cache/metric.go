@@ -2,6 +2,8 @@ package cache
 
 import (
 	"context"
+	"io"
+	"time"
 
 	"github.com/eko/gocache/v3/metrics"
 	"github.com/eko/gocache/v3/store"
@@ -35,11 +37,168 @@ func (c *MetricCache[T]) Get(ctx context.Context, key any) (T, error) {
 	return result, err
 }
 
+// GetWithTTL obtains a value and its remaining TTL from cache, when
+// supported, and also records metrics
+func (c *MetricCache[T]) GetWithTTL(ctx context.Context, key any) (T, time.Duration, error) {
+	ttlCache, ok := c.cache.(ttlGetter[T])
+	if !ok {
+		return *new(T), 0, store.ErrUnsupportedOperation
+	}
+
+	result, ttl, err := ttlCache.GetWithTTL(ctx, key)
+
+	c.updateMetrics(c.cache)
+
+	return result, ttl, err
+}
+
 // Set sets a value from the cache
 func (c *MetricCache[T]) Set(ctx context.Context, key any, object T, options ...store.Option) error {
 	return c.cache.Set(ctx, key, object, options...)
 }
 
+// GetMany obtains values from cache and also records metrics
+func (c *MetricCache[T]) GetMany(ctx context.Context, keys []any) (map[any]T, error) {
+	result, err := getMany(ctx, c.cache, keys)
+
+	c.updateMetrics(c.cache)
+
+	return result, err
+}
+
+// SetMany sets values in the cache
+func (c *MetricCache[T]) SetMany(ctx context.Context, items map[any]T, options ...store.Option) error {
+	return setMany(ctx, c.cache, items, options...)
+}
+
+// DeleteMany removes values from the cache
+func (c *MetricCache[T]) DeleteMany(ctx context.Context, keys []any) error {
+	return deleteMany(ctx, c.cache, keys)
+}
+
+// SetIfNotExists sets a value in the cache only when it does not exist yet, when supported
+func (c *MetricCache[T]) SetIfNotExists(ctx context.Context, key any, object T, options ...store.Option) error {
+	conditionalCache, ok := c.cache.(ConditionalCacheInterface[T])
+	if !ok {
+		return store.ErrUnsupportedOperation
+	}
+
+	return conditionalCache.SetIfNotExists(ctx, key, object, options...)
+}
+
+// GetWithVersion obtains a value and its version from cache and also records metrics, when supported
+func (c *MetricCache[T]) GetWithVersion(ctx context.Context, key any) (T, store.Version, error) {
+	versionedCache, ok := c.cache.(VersionedCacheInterface[T])
+	if !ok {
+		return *new(T), store.Version{}, store.ErrUnsupportedOperation
+	}
+
+	result, version, err := versionedCache.GetWithVersion(ctx, key)
+
+	c.updateMetrics(c.cache)
+
+	return result, version, err
+}
+
+// SetIfVersion sets a value in the cache only when it has not changed since the given version, when supported
+func (c *MetricCache[T]) SetIfVersion(ctx context.Context, key any, object T, version store.Version, options ...store.Option) error {
+	versionedCache, ok := c.cache.(VersionedCacheInterface[T])
+	if !ok {
+		return store.ErrUnsupportedOperation
+	}
+
+	return versionedCache.SetIfVersion(ctx, key, object, version, options...)
+}
+
+// DeleteIfVersion removes a value from the cache only when it has not changed since the given version, when supported
+func (c *MetricCache[T]) DeleteIfVersion(ctx context.Context, key any, version store.Version) error {
+	versionedCache, ok := c.cache.(VersionedCacheInterface[T])
+	if !ok {
+		return store.ErrUnsupportedOperation
+	}
+
+	return versionedCache.DeleteIfVersion(ctx, key, version)
+}
+
+// Exists checks whether a value exists in cache and also records metrics, when supported
+func (c *MetricCache[T]) Exists(ctx context.Context, key any) (bool, error) {
+	keyCache, ok := c.cache.(KeyCacheInterface)
+	if !ok {
+		return false, store.ErrUnsupportedOperation
+	}
+
+	exists, err := keyCache.Exists(ctx, key)
+
+	c.updateMetrics(c.cache)
+
+	return exists, err
+}
+
+// Touch updates the expiration of a value in cache and also records metrics, when supported
+func (c *MetricCache[T]) Touch(ctx context.Context, key any, ttl time.Duration) error {
+	keyCache, ok := c.cache.(KeyCacheInterface)
+	if !ok {
+		return store.ErrUnsupportedOperation
+	}
+
+	err := keyCache.Touch(ctx, key, ttl)
+
+	c.updateMetrics(c.cache)
+
+	return err
+}
+
+// Scan calls fn for each key of the cache matching the given pattern, when supported
+func (c *MetricCache[T]) Scan(ctx context.Context, pattern string, fn func(key string) error) error {
+	scanCache, ok := c.cache.(ScanCacheInterface)
+	if !ok {
+		return store.ErrUnsupportedOperation
+	}
+
+	return scanCache.Scan(ctx, pattern, fn)
+}
+
+// Increment atomically increments a counter of the cache, when supported
+func (c *MetricCache[T]) Increment(ctx context.Context, key any, delta int64, options ...store.Option) (int64, error) {
+	counterCache, ok := c.cache.(CounterCacheInterface)
+	if !ok {
+		return 0, store.ErrUnsupportedOperation
+	}
+
+	return counterCache.Increment(ctx, key, delta, options...)
+}
+
+// Decrement atomically decrements a counter of the cache, when supported
+func (c *MetricCache[T]) Decrement(ctx context.Context, key any, delta int64, options ...store.Option) (int64, error) {
+	return c.Increment(ctx, key, -delta, options...)
+}
+
+// GetCounter obtains a counter of the cache without changing it, when supported
+func (c *MetricCache[T]) GetCounter(ctx context.Context, key any) (int64, error) {
+	counterCache, ok := c.cache.(CounterCacheInterface)
+	if !ok {
+		return 0, store.ErrUnsupportedOperation
+	}
+
+	return counterCache.GetCounter(ctx, key)
+}
+
+// SetCounterIfNotExists initializes a counter of the cache only when it does
+// not exist yet, when supported
+func (c *MetricCache[T]) SetCounterIfNotExists(ctx context.Context, key any, counter int64, options ...store.Option) error {
+	counterCache, ok := c.cache.(CounterCacheInterface)
+	if !ok {
+		return store.ErrUnsupportedOperation
+	}
+
+	return counterCache.SetCounterIfNotExists(ctx, key, counter, options...)
+}
+
+// GetKeyGenerator returns the key generator of the wrapped cache
+func (c *MetricCache[T]) GetKeyGenerator() KeyGenerator {
+	return getKeyGenerator(c.cache)
+}
+
 // Delete removes a value from the cache
 func (c *MetricCache[T]) Delete(ctx context.Context, key any) error {
 	return c.cache.Delete(ctx, key)
@@ -63,11 +222,39 @@ func (c *MetricCache[T]) updateMetrics(cache CacheInterface[T]) {
 			c.updateMetrics(cache)
 		}
 
+	case *NamespacedCache[T]:
+		c.updateMetrics(current.GetCache())
+
 	case SetterCacheInterface[T]:
 		c.metrics.RecordFromCodec(current.GetCodec())
 	}
 }
 
+// Flush waits for the metrics queued to be recorded in background, when the
+// metrics provider records them in background
+func (c *MetricCache[T]) Flush(ctx context.Context) error {
+	if flusher, ok := c.metrics.(FlushCacheInterface); ok {
+		return flusher.Flush(ctx)
+	}
+
+	return nil
+}
+
+// Close closes the metrics provider, when it can be closed. The wrapped cache
+// is not closed, see CloseAll.
+func (c *MetricCache[T]) Close() error {
+	if closer, ok := c.metrics.(io.Closer); ok {
+		return closer.Close()
+	}
+
+	return nil
+}
+
+// GetComponents returns the wrapped cache
+func (c *MetricCache[T]) GetComponents() []any {
+	return []any{c.cache}
+}
+
 // GetType returns the cache type
 func (c *MetricCache[T]) GetType() string {
 	return MetricType
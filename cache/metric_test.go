@@ -61,6 +61,31 @@ func TestMetricGet(t *testing.T) {
 	assert.Equal(t, cacheValue, value)
 }
 
+func TestMetricGetMany(t *testing.T) {
+	// Given
+	ctrl := gomock.NewController(t)
+
+	ctx := context.Background()
+
+	codec1 := mocksCodec.NewMockCodecInterface(ctrl)
+	cache1 := mocksCache.NewMockSetterCacheInterface[any](ctrl)
+	cache1.EXPECT().Get(ctx, "key-1").Return("value 1", nil)
+	cache1.EXPECT().Get(ctx, "key-2").Return(nil, errors.New("unable to find in cache 1"))
+	cache1.EXPECT().GetCodec().Return(codec1)
+
+	metrics := mocksMetrics.NewMockMetricsInterface(ctrl)
+	metrics.EXPECT().RecordFromCodec(codec1)
+
+	cache := NewMetric[any](metrics, cache1)
+
+	// When
+	values, err := cache.GetMany(ctx, []any{"key-1", "key-2"})
+
+	// Then
+	assert.Nil(t, err)
+	assert.Equal(t, map[any]any{"key-1": "value 1"}, values)
+}
+
 func TestMetricGetWhenChainCache(t *testing.T) {
 	// Given
 	ctrl := gomock.NewController(t)
@@ -0,0 +1,238 @@
+package cache
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/eko/gocache/v3/store"
+)
+
+const (
+	// NamespacedType represents the namespaced cache type as a string value
+	NamespacedType = "namespaced"
+	// NamespaceGenerationKeyPattern represents the key holding the current
+	// generation of a namespace in the wrapped cache
+	NamespaceGenerationKeyPattern = "gocache_generation_%s"
+)
+
+// NamespacedCache is a cache prefixing every key with a namespace and its
+// current generation, which is kept as a counter in the wrapped cache.
+// Clearing the namespace increments its generation so that all its keys become
+// unreachable at once without scanning them, previous entries being evicted
+// when they expire. The wrapped cache has to handle counters: chained caches
+// keep them in their last layer and loadable caches in the cache they wrap.
+//
+// Key objects are turned into cache keys using the key generator of the
+// wrapped cache when it exposes it.
+type NamespacedCache[T any] struct {
+	cache     CacheInterface[T]
+	namespace string
+}
+
+// NewNamespaced instantiates a new cache restricted to the given namespace
+func NewNamespaced[T any](cache CacheInterface[T], namespace string) *NamespacedCache[T] {
+	return &NamespacedCache[T]{
+		cache:     cache,
+		namespace: namespace,
+	}
+}
+
+// Get returns the object stored in the namespace if it exists
+func (c *NamespacedCache[T]) Get(ctx context.Context, key any) (T, error) {
+	namespacedKey, err := c.key(ctx, key)
+	if err != nil {
+		return *new(T), err
+	}
+
+	return c.cache.Get(ctx, namespacedKey)
+}
+
+// GetWithTTL returns the object stored in the namespace and its remaining TTL,
+// when supported by the wrapped cache
+func (c *NamespacedCache[T]) GetWithTTL(ctx context.Context, key any) (T, time.Duration, error) {
+	ttlCache, ok := c.cache.(ttlGetter[T])
+	if !ok {
+		return *new(T), 0, store.ErrUnsupportedOperation
+	}
+
+	namespacedKey, err := c.key(ctx, key)
+	if err != nil {
+		return *new(T), 0, err
+	}
+
+	return ttlCache.GetWithTTL(ctx, namespacedKey)
+}
+
+// Set populates the cache item of the namespace using the given key
+func (c *NamespacedCache[T]) Set(ctx context.Context, key any, object T, options ...store.Option) error {
+	namespacedKey, err := c.key(ctx, key)
+	if err != nil {
+		return err
+	}
+
+	return c.cache.Set(ctx, namespacedKey, object, options...)
+}
+
+// GetMany returns the objects stored in the namespace for the given keys. Keys
+// that are not found are omitted from the returned map.
+func (c *NamespacedCache[T]) GetMany(ctx context.Context, keys []any) (map[any]T, error) {
+	prefix, err := c.prefix(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	namespacedKeys := make([]any, len(keys))
+	for i, key := range keys {
+		namespacedKeys[i] = prefix + c.cacheKey(key)
+	}
+
+	values, err := getMany(ctx, c.cache, namespacedKeys)
+	if err != nil {
+		return nil, err
+	}
+
+	objects := make(map[any]T, len(values))
+	for i, key := range keys {
+		if object, ok := values[namespacedKeys[i]]; ok {
+			objects[key] = object
+		}
+	}
+
+	return objects, nil
+}
+
+// SetMany populates the cache items of the namespace using the given keys
+func (c *NamespacedCache[T]) SetMany(ctx context.Context, items map[any]T, options ...store.Option) error {
+	prefix, err := c.prefix(ctx)
+	if err != nil {
+		return err
+	}
+
+	namespacedItems := make(map[any]T, len(items))
+	for key, object := range items {
+		namespacedItems[prefix+c.cacheKey(key)] = object
+	}
+
+	return setMany(ctx, c.cache, namespacedItems, options...)
+}
+
+// DeleteMany removes the cache items of the namespace using the given keys
+func (c *NamespacedCache[T]) DeleteMany(ctx context.Context, keys []any) error {
+	prefix, err := c.prefix(ctx)
+	if err != nil {
+		return err
+	}
+
+	namespacedKeys := make([]any, len(keys))
+	for i, key := range keys {
+		namespacedKeys[i] = prefix + c.cacheKey(key)
+	}
+
+	return deleteMany(ctx, c.cache, namespacedKeys)
+}
+
+// Delete removes the cache item of the namespace using the given key
+func (c *NamespacedCache[T]) Delete(ctx context.Context, key any) error {
+	namespacedKey, err := c.key(ctx, key)
+	if err != nil {
+		return err
+	}
+
+	return c.cache.Delete(ctx, namespacedKey)
+}
+
+// Invalidate invalidates cache items of the wrapped cache from given options
+func (c *NamespacedCache[T]) Invalidate(ctx context.Context, options ...store.InvalidateOption) error {
+	return c.cache.Invalidate(ctx, options...)
+}
+
+// Clear makes all the cache items of the namespace unreachable by incrementing
+// its generation
+func (c *NamespacedCache[T]) Clear(ctx context.Context) error {
+	counterCache, ok := c.cache.(CounterCacheInterface)
+	if !ok {
+		return store.ErrUnsupportedOperation
+	}
+
+	_, err := counterCache.Increment(ctx, c.generationKey(), 1)
+	return err
+}
+
+// GetGeneration returns the current generation of the namespace
+func (c *NamespacedCache[T]) GetGeneration(ctx context.Context) (int64, error) {
+	counterCache, ok := c.cache.(CounterCacheInterface)
+	if !ok {
+		return 0, store.ErrUnsupportedOperation
+	}
+
+	generationKey := c.generationKey()
+
+	generation, err := counterCache.GetCounter(ctx, generationKey)
+	if !errors.Is(err, &store.NotFound{}) {
+		return generation, err
+	}
+
+	// A missing generation is initialized with the current time so that the
+	// keys of a previous generation do not become reachable again when it has
+	// been evicted. It is read again afterwards as a concurrent caller may have
+	// initialized it first.
+	err = counterCache.SetCounterIfNotExists(ctx, generationKey, time.Now().UnixNano())
+	if err != nil && !errors.Is(err, &store.AlreadyExists{}) {
+		return 0, err
+	}
+
+	return counterCache.GetCounter(ctx, generationKey)
+}
+
+// GetCache returns the wrapped cache
+func (c *NamespacedCache[T]) GetCache() CacheInterface[T] {
+	return c.cache
+}
+
+// GetKeyGenerator returns the key generator of the wrapped cache
+func (c *NamespacedCache[T]) GetKeyGenerator() KeyGenerator {
+	return getKeyGenerator(c.cache)
+}
+
+// GetComponents returns the wrapped cache
+func (c *NamespacedCache[T]) GetComponents() []any {
+	return []any{c.cache}
+}
+
+// GetType returns the cache type
+func (c *NamespacedCache[T]) GetType() string {
+	return NamespacedType
+}
+
+// generationKey returns the key holding the generation of the namespace
+func (c *NamespacedCache[T]) generationKey() string {
+	return fmt.Sprintf(NamespaceGenerationKeyPattern, c.namespace)
+}
+
+// prefix returns the prefix of the keys of the current namespace generation
+func (c *NamespacedCache[T]) prefix(ctx context.Context) (string, error) {
+	generation, err := c.GetGeneration(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	return c.namespace + store.NamespaceSeparator + strconv.FormatInt(generation, 10) + store.NamespaceSeparator, nil
+}
+
+// key returns the key of the wrapped cache for the given key object
+func (c *NamespacedCache[T]) key(ctx context.Context, key any) (string, error) {
+	prefix, err := c.prefix(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	return prefix + c.cacheKey(key), nil
+}
+
+// cacheKey returns the cache key of the given key object within the namespace
+func (c *NamespacedCache[T]) cacheKey(key any) string {
+	return generateCacheKey(key, c.GetKeyGenerator())
+}
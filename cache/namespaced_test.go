@@ -0,0 +1,293 @@
+package cache
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/eko/gocache/v3/store"
+	mocksCache "github.com/eko/gocache/v3/test/mocks/cache"
+	"github.com/golang/mock/gomock"
+	gocache "github.com/patrickmn/go-cache"
+	"github.com/stretchr/testify/assert"
+)
+
+type namespacedTestCache struct {
+	*mocksCache.MockCacheInterface[any]
+	*mocksCache.MockCounterCacheInterface
+}
+
+func newNamespacedTestCache(ctrl *gomock.Controller) *namespacedTestCache {
+	return &namespacedTestCache{
+		mocksCache.NewMockCacheInterface[any](ctrl),
+		mocksCache.NewMockCounterCacheInterface(ctrl),
+	}
+}
+
+func TestNewNamespaced(t *testing.T) {
+	// Given
+	ctrl := gomock.NewController(t)
+
+	cache1 := mocksCache.NewMockCacheInterface[any](ctrl)
+
+	// When
+	cache := NewNamespaced[any](cache1, "tenant-42")
+
+	// Then
+	assert.IsType(t, new(NamespacedCache[any]), cache)
+	assert.Equal(t, cache1, cache.GetCache())
+	assert.Equal(t, NamespacedType, cache.GetType())
+}
+
+func TestNamespacedGet(t *testing.T) {
+	// Given
+	ctrl := gomock.NewController(t)
+
+	ctx := context.Background()
+
+	cache1 := newNamespacedTestCache(ctrl)
+	cache1.MockCounterCacheInterface.EXPECT().GetCounter(ctx, "gocache_generation_tenant-42").Return(int64(3), nil)
+	cache1.MockCacheInterface.EXPECT().Get(ctx, "tenant-42:3:my-key").Return("my-value", nil)
+
+	cache := NewNamespaced[any](cache1, "tenant-42")
+
+	// When
+	value, err := cache.Get(ctx, "my-key")
+
+	// Then
+	assert.Nil(t, err)
+	assert.Equal(t, "my-value", value)
+}
+
+func TestNamespacedSetWhenGenerationIsMissing(t *testing.T) {
+	// Given
+	ctrl := gomock.NewController(t)
+
+	ctx := context.Background()
+
+	cache1 := newNamespacedTestCache(ctrl)
+	gomock.InOrder(
+		cache1.MockCounterCacheInterface.EXPECT().GetCounter(ctx, "gocache_generation_tenant-42").Return(int64(0), store.NotFound{}),
+		cache1.MockCounterCacheInterface.EXPECT().SetCounterIfNotExists(ctx, "gocache_generation_tenant-42", gomock.Any()).Return(nil),
+		cache1.MockCounterCacheInterface.EXPECT().GetCounter(ctx, "gocache_generation_tenant-42").Return(int64(1234), nil),
+	)
+	cache1.MockCacheInterface.EXPECT().Set(ctx, "tenant-42:1234:my-key", "my-value").Return(nil)
+
+	cache := NewNamespaced[any](cache1, "tenant-42")
+
+	// When
+	err := cache.Set(ctx, "my-key", "my-value")
+
+	// Then
+	assert.Nil(t, err)
+}
+
+func TestNamespacedSetWhenGenerationIsInitializedConcurrently(t *testing.T) {
+	// Given
+	ctrl := gomock.NewController(t)
+
+	ctx := context.Background()
+
+	cache1 := newNamespacedTestCache(ctrl)
+	gomock.InOrder(
+		cache1.MockCounterCacheInterface.EXPECT().GetCounter(ctx, "gocache_generation_tenant-42").Return(int64(0), store.NotFound{}),
+		cache1.MockCounterCacheInterface.EXPECT().SetCounterIfNotExists(ctx, "gocache_generation_tenant-42", gomock.Any()).Return(store.AlreadyExists{}),
+		cache1.MockCounterCacheInterface.EXPECT().GetCounter(ctx, "gocache_generation_tenant-42").Return(int64(1234), nil),
+	)
+	cache1.MockCacheInterface.EXPECT().Set(ctx, "tenant-42:1234:my-key", "my-value").Return(nil)
+
+	cache := NewNamespaced[any](cache1, "tenant-42")
+
+	// When
+	err := cache.Set(ctx, "my-key", "my-value")
+
+	// Then
+	assert.Nil(t, err)
+}
+
+func TestNamespacedGenerationWithStore(t *testing.T) {
+	// Given
+	ctx := context.Background()
+
+	cache1 := New[any](store.NewGoCache(gocache.New(gocache.NoExpiration, time.Minute)))
+
+	var wg sync.WaitGroup
+	generations := make([]int64, 10)
+
+	// When
+	for i := range generations {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			generations[i], _ = NewNamespaced[any](cache1, "tenant-42").GetGeneration(ctx)
+		}(i)
+	}
+	wg.Wait()
+
+	// Then
+	assert.NotZero(t, generations[0])
+	for _, generation := range generations {
+		assert.Equal(t, generations[0], generation)
+	}
+
+	generation, err := NewNamespaced[any](cache1, "tenant-42").GetGeneration(ctx)
+	assert.Nil(t, err)
+	assert.Equal(t, generations[0], generation)
+}
+
+func TestNamespacedWithChain(t *testing.T) {
+	// Given
+	ctx := context.Background()
+
+	cache1 := New[any](store.NewGoCache(gocache.New(gocache.NoExpiration, time.Minute)))
+	cache2 := New[any](store.NewGoCache(gocache.New(gocache.NoExpiration, time.Minute)))
+
+	chain := NewChainWithOptions[any]([]SetterCacheInterface[any]{cache1, cache2},
+		WithBackfillPolicy(BackfillSync),
+	)
+	defer chain.Close()
+
+	cache := NewNamespaced[any](chain, "tenant-42")
+
+	// When
+	err := cache.Set(ctx, "my-key", "my-value")
+	assert.Nil(t, err)
+
+	value, err := cache.Get(ctx, "my-key")
+	assert.Nil(t, err)
+	assert.Equal(t, "my-value", value)
+
+	err = cache.Clear(ctx)
+	assert.Nil(t, err)
+
+	// Then
+	_, err = cache.Get(ctx, "my-key")
+	assert.True(t, errors.Is(err, &store.NotFound{}))
+
+	// The generation is kept in the last cache layer only
+	generation, err := cache2.GetCounter(ctx, fmt.Sprintf(NamespaceGenerationKeyPattern, "tenant-42"))
+	assert.Nil(t, err)
+
+	chainGeneration, err := cache.GetGeneration(ctx)
+	assert.Nil(t, err)
+	assert.Equal(t, generation, chainGeneration)
+
+	_, err = cache1.GetCounter(ctx, fmt.Sprintf(NamespaceGenerationKeyPattern, "tenant-42"))
+	assert.True(t, errors.Is(err, &store.NotFound{}))
+}
+
+func TestNamespacedWithLoadable(t *testing.T) {
+	// Given
+	ctx := context.Background()
+
+	cache1 := New[any](store.NewGoCache(gocache.New(gocache.NoExpiration, time.Minute)))
+
+	loads := 0
+	loadable := NewLoadable[any](func(ctx context.Context, key any) (any, error) {
+		loads++
+		return fmt.Sprintf("value-%d", loads), nil
+	}, cache1)
+	defer loadable.Close()
+
+	cache := NewNamespaced[any](loadable, "tenant-42")
+
+	value, err := cache.Get(ctx, "my-key")
+	assert.Nil(t, err)
+	assert.Equal(t, "value-1", value)
+
+	// When
+	err = cache.Clear(ctx)
+
+	// Then
+	assert.Nil(t, err)
+
+	value, err = cache.Get(ctx, "my-key")
+	assert.Nil(t, err)
+	assert.Equal(t, "value-2", value)
+}
+
+func TestNamespacedSetWithKeyGenerator(t *testing.T) {
+	// Given
+	ctx := context.Background()
+
+	type userKey struct {
+		ID int
+	}
+
+	cache1 := New[any](store.NewGoCache(gocache.New(gocache.NoExpiration, time.Minute)), WithKeyGenerator(ReadableKeyGenerator))
+
+	cache := NewNamespaced[any](cache1, "tenant-42")
+
+	generation, err := cache.GetGeneration(ctx)
+	assert.Nil(t, err)
+
+	// When
+	err = cache.Set(ctx, userKey{ID: 42}, "my-value")
+
+	// Then
+	assert.Nil(t, err)
+
+	value, err := cache1.Get(ctx, fmt.Sprintf("tenant-42:%d:userKey:ID=42", generation))
+	assert.Nil(t, err)
+	assert.Equal(t, "my-value", value)
+}
+
+func TestNamespacedGetMany(t *testing.T) {
+	// Given
+	ctrl := gomock.NewController(t)
+
+	ctx := context.Background()
+
+	cache1 := newNamespacedTestCache(ctrl)
+	cache1.MockCounterCacheInterface.EXPECT().GetCounter(ctx, "gocache_generation_tenant-42").Return(int64(3), nil)
+	cache1.MockCacheInterface.EXPECT().Get(ctx, "tenant-42:3:my-key1").Return("my-value1", nil)
+	cache1.MockCacheInterface.EXPECT().Get(ctx, "tenant-42:3:my-key2").Return(nil, store.NotFound{})
+
+	cache := NewNamespaced[any](cache1, "tenant-42")
+
+	// When
+	values, err := cache.GetMany(ctx, []any{"my-key1", "my-key2"})
+
+	// Then
+	assert.Nil(t, err)
+	assert.Equal(t, map[any]any{"my-key1": "my-value1"}, values)
+}
+
+func TestNamespacedClear(t *testing.T) {
+	// Given
+	ctrl := gomock.NewController(t)
+
+	ctx := context.Background()
+
+	cache1 := newNamespacedTestCache(ctrl)
+	cache1.MockCounterCacheInterface.EXPECT().Increment(ctx, "gocache_generation_tenant-42", int64(1)).Return(int64(4), nil)
+
+	cache := NewNamespaced[any](cache1, "tenant-42")
+
+	// When
+	err := cache.Clear(ctx)
+
+	// Then
+	assert.Nil(t, err)
+}
+
+func TestNamespacedGetWhenCountersAreUnsupported(t *testing.T) {
+	// Given
+	ctrl := gomock.NewController(t)
+
+	ctx := context.Background()
+
+	cache1 := mocksCache.NewMockCacheInterface[any](ctrl)
+
+	cache := NewNamespaced[any](cache1, "tenant-42")
+
+	// When
+	value, err := cache.Get(ctx, "my-key")
+
+	// Then
+	assert.Nil(t, value)
+	assert.Equal(t, store.ErrUnsupportedOperation, err)
+}
@@ -0,0 +1,60 @@
+package cache
+
+import (
+	"errors"
+	"sync"
+)
+
+var errLoadPanicked = errors.New("load function panicked")
+
+// loadCall represents an in-flight load for a given key
+type loadCall[T any] struct {
+	wg    sync.WaitGroup
+	value T
+	err   error
+	dups  int
+}
+
+// loadGroup deduplicates concurrent loads of a same key so that only one of
+// them is executed while the other callers wait for it and share its result
+type loadGroup[T any] struct {
+	mu    sync.Mutex
+	calls map[string]*loadCall[T]
+}
+
+func newLoadGroup[T any]() *loadGroup[T] {
+	return &loadGroup[T]{
+		calls: make(map[string]*loadCall[T]),
+	}
+}
+
+// do executes the given function, making sure only one execution is in-flight
+// for a given key at a time. The returned shared value reports whether the
+// result was obtained from a call made by another caller.
+func (g *loadGroup[T]) do(key string, fn func() (T, error)) (value T, shared bool, err error) {
+	g.mu.Lock()
+	if call, ok := g.calls[key]; ok {
+		call.dups++
+		g.mu.Unlock()
+
+		call.wg.Wait()
+		return call.value, true, call.err
+	}
+
+	call := &loadCall[T]{err: errLoadPanicked}
+	call.wg.Add(1)
+	g.calls[key] = call
+	g.mu.Unlock()
+
+	defer func() {
+		g.mu.Lock()
+		delete(g.calls, key)
+		g.mu.Unlock()
+
+		call.wg.Done()
+	}()
+
+	call.value, call.err = fn()
+
+	return call.value, false, call.err
+}
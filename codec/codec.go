@@ -2,6 +2,7 @@ package codec
 
 import (
 	"context"
+	"errors"
 	"sync"
 	"time"
 
@@ -20,6 +21,8 @@ type Stats struct {
 	InvalidateError   int
 	ClearSuccess      int
 	ClearError        int
+	TouchSuccess      int
+	TouchError        int
 }
 
 // Codec represents an instance of a cache store
@@ -128,6 +131,290 @@ func (c *Codec) Clear(ctx context.Context) error {
 	return err
 }
 
+// GetMany allows to retrieve the values from given key identifiers. Keys that are
+// not found are omitted from the returned map and counted as misses.
+func (c *Codec) GetMany(ctx context.Context, keys []any) (map[any]any, error) {
+	var values map[any]any
+	var err error
+
+	if bulkStore, ok := c.store.(store.BulkStoreInterface); ok {
+		values, err = bulkStore.GetMany(ctx, keys)
+	} else {
+		values = make(map[any]any, len(keys))
+		for _, key := range keys {
+			if value, getErr := c.store.Get(ctx, key); getErr == nil {
+				values[key] = value
+			}
+		}
+	}
+
+	c.statsMtx.Lock()
+	defer c.statsMtx.Unlock()
+	if err == nil {
+		c.stats.Hits += len(values)
+		c.stats.Miss += len(keys) - len(values)
+	} else {
+		c.stats.Miss += len(keys)
+	}
+
+	return values, err
+}
+
+// GetManyWithTTL allows to retrieve the values from given key identifiers along
+// with their remaining TTL. Keys that are not found are omitted from the
+// returned maps and counted as misses.
+func (c *Codec) GetManyWithTTL(ctx context.Context, keys []any) (map[any]any, map[any]time.Duration, error) {
+	var values map[any]any
+	var ttls map[any]time.Duration
+	var err error
+
+	if bulkStore, ok := c.store.(store.BulkTTLStoreInterface); ok {
+		values, ttls, err = bulkStore.GetManyWithTTL(ctx, keys)
+	} else {
+		values = make(map[any]any, len(keys))
+		ttls = make(map[any]time.Duration, len(keys))
+		for _, key := range keys {
+			if value, ttl, getErr := c.store.GetWithTTL(ctx, key); getErr == nil {
+				values[key] = value
+				ttls[key] = ttl
+			}
+		}
+	}
+
+	c.statsMtx.Lock()
+	defer c.statsMtx.Unlock()
+	if err == nil {
+		c.stats.Hits += len(values)
+		c.stats.Miss += len(keys) - len(values)
+	} else {
+		c.stats.Miss += len(keys)
+	}
+
+	return values, ttls, err
+}
+
+// SetMany allows to set values for given key identifiers and also allows to specify
+// an expiration time
+func (c *Codec) SetMany(ctx context.Context, items map[any]any, options ...store.Option) error {
+	var err error
+
+	if bulkStore, ok := c.store.(store.BulkStoreInterface); ok {
+		err = bulkStore.SetMany(ctx, items, options...)
+	} else {
+		for key, value := range items {
+			if err = c.store.Set(ctx, key, value, options...); err != nil {
+				break
+			}
+		}
+	}
+
+	c.statsMtx.Lock()
+	defer c.statsMtx.Unlock()
+	if err == nil {
+		c.stats.SetSuccess += len(items)
+	} else {
+		c.stats.SetError += len(items)
+	}
+
+	return err
+}
+
+// DeleteMany allows to remove values for given key identifiers
+func (c *Codec) DeleteMany(ctx context.Context, keys []any) error {
+	var err error
+
+	if bulkStore, ok := c.store.(store.BulkStoreInterface); ok {
+		err = bulkStore.DeleteMany(ctx, keys)
+	} else {
+		for _, key := range keys {
+			if err = c.store.Delete(ctx, key); err != nil {
+				break
+			}
+		}
+	}
+
+	c.statsMtx.Lock()
+	defer c.statsMtx.Unlock()
+	if err == nil {
+		c.stats.DeleteSuccess += len(keys)
+	} else {
+		c.stats.DeleteError += len(keys)
+	}
+
+	return err
+}
+
+// SetIfNotExists allows to set a value for a given key identifier only when it
+// does not exist yet. It returns a store.AlreadyExists error otherwise, and
+// store.ErrUnsupportedOperation when the store does not handle it.
+func (c *Codec) SetIfNotExists(ctx context.Context, key any, value any, options ...store.Option) error {
+	conditionalStore, ok := c.store.(store.ConditionalStoreInterface)
+	if !ok {
+		return store.ErrUnsupportedOperation
+	}
+
+	err := conditionalStore.SetIfNotExists(ctx, key, value, options...)
+
+	c.statsMtx.Lock()
+	defer c.statsMtx.Unlock()
+	if err == nil {
+		c.stats.SetSuccess++
+	} else if !errors.Is(err, &store.AlreadyExists{}) {
+		c.stats.SetError++
+	}
+
+	return err
+}
+
+// GetWithVersion allows to retrieve the value from a given key identifier and
+// its version. It returns store.ErrUnsupportedOperation when the store does not
+// handle versions.
+func (c *Codec) GetWithVersion(ctx context.Context, key any) (any, store.Version, error) {
+	versionedStore, ok := c.store.(store.VersionedStoreInterface)
+	if !ok {
+		return nil, store.Version{}, store.ErrUnsupportedOperation
+	}
+
+	val, version, err := versionedStore.GetWithVersion(ctx, key)
+
+	c.statsMtx.Lock()
+	defer c.statsMtx.Unlock()
+	if err == nil {
+		c.stats.Hits++
+	} else {
+		c.stats.Miss++
+	}
+
+	return val, version, err
+}
+
+// SetIfVersion allows to set a value for a given key identifier only when it
+// has not changed since the given version. It returns a store.VersionConflict
+// error otherwise.
+func (c *Codec) SetIfVersion(ctx context.Context, key any, value any, version store.Version, options ...store.Option) error {
+	versionedStore, ok := c.store.(store.VersionedStoreInterface)
+	if !ok {
+		return store.ErrUnsupportedOperation
+	}
+
+	err := versionedStore.SetIfVersion(ctx, key, value, version, options...)
+
+	c.statsMtx.Lock()
+	defer c.statsMtx.Unlock()
+	if err == nil {
+		c.stats.SetSuccess++
+	} else if !errors.Is(err, &store.VersionConflict{}) {
+		c.stats.SetError++
+	}
+
+	return err
+}
+
+// DeleteIfVersion allows to remove a value for a given key identifier only when
+// it has not changed since the given version. It returns a store.VersionConflict
+// error otherwise.
+func (c *Codec) DeleteIfVersion(ctx context.Context, key any, version store.Version) error {
+	versionedStore, ok := c.store.(store.VersionedStoreInterface)
+	if !ok {
+		return store.ErrUnsupportedOperation
+	}
+
+	err := versionedStore.DeleteIfVersion(ctx, key, version)
+
+	c.statsMtx.Lock()
+	defer c.statsMtx.Unlock()
+	if err == nil {
+		c.stats.DeleteSuccess++
+	} else if !errors.Is(err, &store.VersionConflict{}) {
+		c.stats.DeleteError++
+	}
+
+	return err
+}
+
+// Exists allows to check whether a given key identifier exists. Stores unable
+// to check it natively fall back to retrieving the value.
+func (c *Codec) Exists(ctx context.Context, key any) (bool, error) {
+	var exists bool
+	var err error
+
+	if existsStore, ok := c.store.(store.ExistsStoreInterface); ok {
+		exists, err = existsStore.Exists(ctx, key)
+	} else {
+		_, err = c.store.Get(ctx, key)
+		exists = err == nil
+		if errors.Is(err, &store.NotFound{}) {
+			err = nil
+		}
+	}
+
+	c.statsMtx.Lock()
+	defer c.statsMtx.Unlock()
+	if exists {
+		c.stats.Hits++
+	} else {
+		c.stats.Miss++
+	}
+
+	return exists, err
+}
+
+// Touch allows to update the expiration of a given key identifier without
+// rewriting its value. It returns store.ErrUnsupportedOperation when the store
+// does not handle it.
+func (c *Codec) Touch(ctx context.Context, key any, ttl time.Duration) error {
+	touchStore, ok := c.store.(store.TouchStoreInterface)
+	if !ok {
+		return store.ErrUnsupportedOperation
+	}
+
+	err := touchStore.Touch(ctx, key, ttl)
+
+	c.statsMtx.Lock()
+	defer c.statsMtx.Unlock()
+	if err == nil {
+		c.stats.TouchSuccess++
+	} else {
+		c.stats.TouchError++
+	}
+
+	return err
+}
+
+// Increment allows to atomically add delta to the integer stored at a given key
+// identifier. It returns store.ErrUnsupportedOperation when the store does not
+// handle counters.
+func (c *Codec) Increment(ctx context.Context, key any, delta int64, options ...store.Option) (int64, error) {
+	counterStore, ok := c.store.(store.CounterStoreInterface)
+	if !ok {
+		return 0, store.ErrUnsupportedOperation
+	}
+
+	counter, err := counterStore.Increment(ctx, key, delta, options...)
+
+	c.statsMtx.Lock()
+	defer c.statsMtx.Unlock()
+	if err == nil {
+		c.stats.SetSuccess++
+	} else {
+		c.stats.SetError++
+	}
+
+	return counter, err
+}
+
+// Scan allows to call fn for each key identifier matching the given pattern. It
+// returns store.ErrUnsupportedOperation when the store is not able to
+// enumerate its keys.
+func (c *Codec) Scan(ctx context.Context, pattern string, fn func(key string) error) error {
+	scanStore, ok := c.store.(store.ScanStoreInterface)
+	if !ok {
+		return store.ErrUnsupportedOperation
+	}
+
+	return scanStore.Scan(ctx, pattern, fn)
+}
+
 // GetStore returns the store associated to this codec
 func (c *Codec) GetStore() store.StoreInterface {
 	return c.store
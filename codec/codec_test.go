@@ -423,6 +423,391 @@ func TestClearWhenError(t *testing.T) {
 	assert.Equal(t, 1, codec.GetStats().ClearError)
 }
 
+func TestGetManyWhenBulkStore(t *testing.T) {
+	// Given
+	ctrl := gomock.NewController(t)
+
+	ctx := context.Background()
+
+	bulkStore := mocksStore.NewMockBulkStoreInterface(ctrl)
+	bulkStore.EXPECT().GetMany(ctx, []any{"key-1", "key-2", "key-3"}).Return(map[any]any{
+		"key-1": "value 1",
+		"key-3": "value 3",
+	}, nil)
+
+	codec := New(&struct {
+		*mocksStore.MockStoreInterface
+		*mocksStore.MockBulkStoreInterface
+	}{
+		mocksStore.NewMockStoreInterface(ctrl),
+		bulkStore,
+	})
+
+	// When
+	values, err := codec.GetMany(ctx, []any{"key-1", "key-2", "key-3"})
+
+	// Then
+	assert.Nil(t, err)
+	assert.Equal(t, map[any]any{"key-1": "value 1", "key-3": "value 3"}, values)
+
+	assert.Equal(t, 2, codec.GetStats().Hits)
+	assert.Equal(t, 1, codec.GetStats().Miss)
+}
+
+func TestGetManyWhenNotBulkStore(t *testing.T) {
+	// Given
+	ctrl := gomock.NewController(t)
+
+	ctx := context.Background()
+
+	store := mocksStore.NewMockStoreInterface(ctrl)
+	store.EXPECT().Get(ctx, "key-1").Return("value 1", nil)
+	store.EXPECT().Get(ctx, "key-2").Return(nil, errors.New("unable to find in store"))
+
+	codec := New(store)
+
+	// When
+	values, err := codec.GetMany(ctx, []any{"key-1", "key-2"})
+
+	// Then
+	assert.Nil(t, err)
+	assert.Equal(t, map[any]any{"key-1": "value 1"}, values)
+
+	assert.Equal(t, 1, codec.GetStats().Hits)
+	assert.Equal(t, 1, codec.GetStats().Miss)
+}
+
+func TestGetManyWithTTLWhenBulkStore(t *testing.T) {
+	// Given
+	ctrl := gomock.NewController(t)
+
+	ctx := context.Background()
+
+	bulkStore := mocksStore.NewMockBulkTTLStoreInterface(ctrl)
+	bulkStore.EXPECT().GetManyWithTTL(ctx, []any{"key-1", "key-2"}).Return(
+		map[any]any{"key-1": "value 1"},
+		map[any]time.Duration{"key-1": 5 * time.Second},
+		nil,
+	)
+
+	codec := New(&struct {
+		*mocksStore.MockStoreInterface
+		*mocksStore.MockBulkTTLStoreInterface
+	}{
+		mocksStore.NewMockStoreInterface(ctrl),
+		bulkStore,
+	})
+
+	// When
+	values, ttls, err := codec.GetManyWithTTL(ctx, []any{"key-1", "key-2"})
+
+	// Then
+	assert.Nil(t, err)
+	assert.Equal(t, map[any]any{"key-1": "value 1"}, values)
+	assert.Equal(t, map[any]time.Duration{"key-1": 5 * time.Second}, ttls)
+
+	assert.Equal(t, 1, codec.GetStats().Hits)
+	assert.Equal(t, 1, codec.GetStats().Miss)
+}
+
+func TestGetManyWithTTLWhenNotBulkStore(t *testing.T) {
+	// Given
+	ctrl := gomock.NewController(t)
+
+	ctx := context.Background()
+
+	store := mocksStore.NewMockStoreInterface(ctrl)
+	store.EXPECT().GetWithTTL(ctx, "key-1").Return("value 1", 5*time.Second, nil)
+	store.EXPECT().GetWithTTL(ctx, "key-2").Return(nil, 0*time.Second, errors.New("unable to find in store"))
+
+	codec := New(store)
+
+	// When
+	values, ttls, err := codec.GetManyWithTTL(ctx, []any{"key-1", "key-2"})
+
+	// Then
+	assert.Nil(t, err)
+	assert.Equal(t, map[any]any{"key-1": "value 1"}, values)
+	assert.Equal(t, map[any]time.Duration{"key-1": 5 * time.Second}, ttls)
+
+	assert.Equal(t, 1, codec.GetStats().Hits)
+	assert.Equal(t, 1, codec.GetStats().Miss)
+}
+
+func TestSetManyWhenNotBulkStore(t *testing.T) {
+	// Given
+	ctrl := gomock.NewController(t)
+
+	ctx := context.Background()
+
+	mockedStore := mocksStore.NewMockStoreInterface(ctrl)
+	mockedStore.EXPECT().Set(ctx, "key-1", "value 1", store.OptionsMatcher{
+		Expiration: 5 * time.Second,
+	}).Return(nil)
+	mockedStore.EXPECT().Set(ctx, "key-2", "value 2", store.OptionsMatcher{
+		Expiration: 5 * time.Second,
+	}).Return(nil)
+
+	codec := New(mockedStore)
+
+	// When
+	err := codec.SetMany(ctx, map[any]any{"key-1": "value 1", "key-2": "value 2"}, store.WithExpiration(5*time.Second))
+
+	// Then
+	assert.Nil(t, err)
+
+	assert.Equal(t, 2, codec.GetStats().SetSuccess)
+	assert.Equal(t, 0, codec.GetStats().SetError)
+}
+
+func TestDeleteManyWhenBulkStoreError(t *testing.T) {
+	// Given
+	ctrl := gomock.NewController(t)
+
+	ctx := context.Background()
+
+	expectedErr := errors.New("unable to delete keys")
+
+	bulkStore := mocksStore.NewMockBulkStoreInterface(ctrl)
+	bulkStore.EXPECT().DeleteMany(ctx, []any{"key-1", "key-2"}).Return(expectedErr)
+
+	codec := New(&struct {
+		*mocksStore.MockStoreInterface
+		*mocksStore.MockBulkStoreInterface
+	}{
+		mocksStore.NewMockStoreInterface(ctrl),
+		bulkStore,
+	})
+
+	// When
+	err := codec.DeleteMany(ctx, []any{"key-1", "key-2"})
+
+	// Then
+	assert.Equal(t, expectedErr, err)
+
+	assert.Equal(t, 0, codec.GetStats().DeleteSuccess)
+	assert.Equal(t, 2, codec.GetStats().DeleteError)
+}
+
+func TestSetIfNotExistsWhenAlreadyExists(t *testing.T) {
+	// Given
+	ctrl := gomock.NewController(t)
+
+	ctx := context.Background()
+
+	expectedErr := store.AlreadyExistsWithCause(errors.New("value already exists"))
+
+	conditionalStore := mocksStore.NewMockConditionalStoreInterface(ctrl)
+	conditionalStore.EXPECT().SetIfNotExists(ctx, "my-key", "my-value").Return(expectedErr)
+
+	codec := New(&struct {
+		*mocksStore.MockStoreInterface
+		*mocksStore.MockConditionalStoreInterface
+	}{
+		mocksStore.NewMockStoreInterface(ctrl),
+		conditionalStore,
+	})
+
+	// When
+	err := codec.SetIfNotExists(ctx, "my-key", "my-value")
+
+	// Then
+	assert.Equal(t, expectedErr, err)
+
+	assert.Equal(t, 0, codec.GetStats().SetSuccess)
+	assert.Equal(t, 0, codec.GetStats().SetError)
+}
+
+func TestSetIfNotExistsWhenNotConditionalStore(t *testing.T) {
+	// Given
+	ctrl := gomock.NewController(t)
+
+	ctx := context.Background()
+
+	codec := New(mocksStore.NewMockStoreInterface(ctrl))
+
+	// When
+	err := codec.SetIfNotExists(ctx, "my-key", "my-value")
+
+	// Then
+	assert.ErrorIs(t, err, store.ErrUnsupportedOperation)
+}
+
+func TestSetIfVersionWhenConflict(t *testing.T) {
+	// Given
+	ctrl := gomock.NewController(t)
+
+	ctx := context.Background()
+
+	expectedErr := store.VersionConflictWithCause(errors.New("value has changed"))
+
+	versionedStore := mocksStore.NewMockVersionedStoreInterface(ctrl)
+	versionedStore.EXPECT().SetIfVersion(ctx, "my-key", "my-value", store.Version{}).Return(expectedErr)
+
+	codec := New(&struct {
+		*mocksStore.MockStoreInterface
+		*mocksStore.MockVersionedStoreInterface
+	}{
+		mocksStore.NewMockStoreInterface(ctrl),
+		versionedStore,
+	})
+
+	// When
+	err := codec.SetIfVersion(ctx, "my-key", "my-value", store.Version{})
+
+	// Then
+	assert.Equal(t, expectedErr, err)
+
+	assert.Equal(t, 0, codec.GetStats().SetSuccess)
+	assert.Equal(t, 0, codec.GetStats().SetError)
+}
+
+func TestExistsWhenNotExistsStore(t *testing.T) {
+	// Given
+	ctrl := gomock.NewController(t)
+
+	ctx := context.Background()
+
+	mockedStore := mocksStore.NewMockStoreInterface(ctrl)
+	mockedStore.EXPECT().Get(ctx, "my-key").Return(nil, store.NotFoundWithCause(errors.New("value not found")))
+
+	codec := New(mockedStore)
+
+	// When
+	exists, err := codec.Exists(ctx, "my-key")
+
+	// Then
+	assert.Nil(t, err)
+	assert.False(t, exists)
+
+	assert.Equal(t, 0, codec.GetStats().Hits)
+	assert.Equal(t, 1, codec.GetStats().Miss)
+}
+
+func TestTouchWhenError(t *testing.T) {
+	// Given
+	ctrl := gomock.NewController(t)
+
+	ctx := context.Background()
+
+	expectedErr := errors.New("unable to touch key")
+
+	touchStore := mocksStore.NewMockTouchStoreInterface(ctrl)
+	touchStore.EXPECT().Touch(ctx, "my-key", 10*time.Second).Return(expectedErr)
+
+	codec := New(&struct {
+		*mocksStore.MockStoreInterface
+		*mocksStore.MockTouchStoreInterface
+	}{
+		mocksStore.NewMockStoreInterface(ctrl),
+		touchStore,
+	})
+
+	// When
+	err := codec.Touch(ctx, "my-key", 10*time.Second)
+
+	// Then
+	assert.Equal(t, expectedErr, err)
+
+	assert.Equal(t, 0, codec.GetStats().TouchSuccess)
+	assert.Equal(t, 1, codec.GetStats().TouchError)
+}
+
+func TestIncrementWhenCounterStore(t *testing.T) {
+	// Given
+	ctrl := gomock.NewController(t)
+
+	ctx := context.Background()
+
+	counterStore := mocksStore.NewMockCounterStoreInterface(ctrl)
+	counterStore.EXPECT().Increment(ctx, "my-counter", int64(2), store.OptionsMatcher{
+		Expiration: 5 * time.Second,
+	}).Return(int64(4), nil)
+
+	codec := New(&struct {
+		*mocksStore.MockStoreInterface
+		*mocksStore.MockCounterStoreInterface
+	}{
+		mocksStore.NewMockStoreInterface(ctrl),
+		counterStore,
+	})
+
+	// When
+	counter, err := codec.Increment(ctx, "my-counter", 2, store.WithExpiration(5*time.Second))
+
+	// Then
+	assert.Nil(t, err)
+	assert.Equal(t, int64(4), counter)
+
+	assert.Equal(t, 1, codec.GetStats().SetSuccess)
+}
+
+func TestIncrementWhenNotCounterStore(t *testing.T) {
+	// Given
+	ctrl := gomock.NewController(t)
+
+	ctx := context.Background()
+
+	codec := New(mocksStore.NewMockStoreInterface(ctrl))
+
+	// When
+	counter, err := codec.Increment(ctx, "my-counter", 1)
+
+	// Then
+	assert.ErrorIs(t, err, store.ErrUnsupportedOperation)
+	assert.Equal(t, int64(0), counter)
+}
+
+func TestScanWhenScanStore(t *testing.T) {
+	// Given
+	ctrl := gomock.NewController(t)
+
+	ctx := context.Background()
+
+	scanStore := mocksStore.NewMockScanStoreInterface(ctrl)
+	scanStore.EXPECT().Scan(ctx, "my-*", gomock.Any()).DoAndReturn(
+		func(_ context.Context, _ string, fn func(key string) error) error {
+			return fn("my-key")
+		},
+	)
+
+	codec := New(&struct {
+		*mocksStore.MockStoreInterface
+		*mocksStore.MockScanStoreInterface
+	}{
+		mocksStore.NewMockStoreInterface(ctrl),
+		scanStore,
+	})
+
+	// When
+	var keys []string
+	err := codec.Scan(ctx, "my-*", func(key string) error {
+		keys = append(keys, key)
+		return nil
+	})
+
+	// Then
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"my-key"}, keys)
+}
+
+func TestScanWhenNotScanStore(t *testing.T) {
+	// Given
+	ctrl := gomock.NewController(t)
+
+	ctx := context.Background()
+
+	codec := New(mocksStore.NewMockStoreInterface(ctrl))
+
+	// When
+	err := codec.Scan(ctx, "my-*", func(key string) error {
+		return nil
+	})
+
+	// Then
+	assert.Equal(t, store.ErrUnsupportedOperation, err)
+}
+
 func TestGetStore(t *testing.T) {
 	// Given
 	ctrl := gomock.NewController(t)
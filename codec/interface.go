@@ -16,6 +16,19 @@ type CodecInterface interface {
 	Invalidate(ctx context.Context, options ...store.InvalidateOption) error
 	Clear(ctx context.Context) error
 
+	GetMany(ctx context.Context, keys []any) (map[any]any, error)
+	GetManyWithTTL(ctx context.Context, keys []any) (map[any]any, map[any]time.Duration, error)
+	SetMany(ctx context.Context, items map[any]any, options ...store.Option) error
+	DeleteMany(ctx context.Context, keys []any) error
+	SetIfNotExists(ctx context.Context, key any, value any, options ...store.Option) error
+	GetWithVersion(ctx context.Context, key any) (any, store.Version, error)
+	SetIfVersion(ctx context.Context, key any, value any, version store.Version, options ...store.Option) error
+	DeleteIfVersion(ctx context.Context, key any, version store.Version) error
+	Exists(ctx context.Context, key any) (bool, error)
+	Touch(ctx context.Context, key any, ttl time.Duration) error
+	Increment(ctx context.Context, key any, delta int64, options ...store.Option) (int64, error)
+	Scan(ctx context.Context, pattern string, fn func(key string) error) error
+
 	GetStore() store.StoreInterface
 	GetStats() *Stats
 }
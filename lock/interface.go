@@ -0,0 +1,18 @@
+package lock
+
+import (
+	"context"
+	"time"
+)
+
+// LockerInterface represents the interface used to acquire distributed locks
+type LockerInterface interface {
+	Acquire(ctx context.Context, key string, ttl time.Duration) (LockInterface, error)
+}
+
+// LockInterface represents a lock held until it expires or is released
+type LockInterface interface {
+	Renew(ctx context.Context, ttl time.Duration) error
+	Release(ctx context.Context) error
+	Token() string
+}
@@ -0,0 +1,156 @@
+package lock
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/eko/gocache/v3/store"
+)
+
+const (
+	// LockKeyPattern represents the pattern of the keys used to store locks
+	LockKeyPattern = "gocache_lock_%s"
+)
+
+var (
+	// ErrNotAcquired is returned when trying to acquire a lock held by another owner
+	ErrNotAcquired = errors.New("lock is held by another owner")
+	// ErrNotHeld is returned when renewing or releasing a lock that has expired
+	// or has been acquired by another owner in the meantime
+	ErrNotHeld = errors.New("lock is not held anymore")
+)
+
+// Locker acquires locks stored in a store handling conditional and versioned
+// writes, such as Redis, Memcache or the in-memory stores
+type Locker struct {
+	store store.StoreInterface
+}
+
+// New instanciates a new locker using the given store
+func New(store store.StoreInterface) *Locker {
+	return &Locker{
+		store: store,
+	}
+}
+
+// Acquire takes the lock of the given key for the given duration. It returns
+// ErrNotAcquired when the lock is already held.
+func (l *Locker) Acquire(ctx context.Context, key string, ttl time.Duration) (LockInterface, error) {
+	conditionalStore, ok := l.store.(store.ConditionalStoreInterface)
+	if !ok {
+		return nil, store.ErrUnsupportedOperation
+	}
+
+	token, err := newToken()
+	if err != nil {
+		return nil, err
+	}
+
+	lockKey := fmt.Sprintf(LockKeyPattern, key)
+
+	err = conditionalStore.SetIfNotExists(ctx, lockKey, token, store.WithExpiration(ttl))
+	if errors.Is(err, &store.AlreadyExists{}) {
+		return nil, ErrNotAcquired
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return &Lock{
+		store: l.store,
+		key:   lockKey,
+		token: token,
+	}, nil
+}
+
+// Lock represents a lock acquired by a locker
+type Lock struct {
+	store store.StoreInterface
+	key   string
+	token []byte
+}
+
+// Renew extends the lock for the given duration. It returns ErrNotHeld when
+// the lock has expired or has been acquired by another owner.
+func (l *Lock) Renew(ctx context.Context, ttl time.Duration) error {
+	versionedStore, version, err := l.get(ctx)
+	if err != nil {
+		return err
+	}
+
+	err = versionedStore.SetIfVersion(ctx, l.key, l.token, version, store.WithExpiration(ttl))
+	if errors.Is(err, &store.VersionConflict{}) {
+		return ErrNotHeld
+	}
+
+	return err
+}
+
+// Release frees the lock. It returns ErrNotHeld when the lock has expired or
+// has been acquired by another owner.
+func (l *Lock) Release(ctx context.Context) error {
+	versionedStore, version, err := l.get(ctx)
+	if err != nil {
+		return err
+	}
+
+	err = versionedStore.DeleteIfVersion(ctx, l.key, version)
+	if errors.Is(err, &store.VersionConflict{}) {
+		return ErrNotHeld
+	}
+
+	return err
+}
+
+// Token returns the random token identifying the owner of the lock
+func (l *Lock) Token() string {
+	return string(l.token)
+}
+
+// get returns the version of the lock value, making sure it is still owned
+func (l *Lock) get(ctx context.Context) (store.VersionedStoreInterface, store.Version, error) {
+	versionedStore, ok := l.store.(store.VersionedStoreInterface)
+	if !ok {
+		return nil, store.Version{}, store.ErrUnsupportedOperation
+	}
+
+	value, version, err := versionedStore.GetWithVersion(ctx, l.key)
+	if errors.Is(err, &store.NotFound{}) {
+		return nil, store.Version{}, ErrNotHeld
+	}
+	if err != nil {
+		return nil, store.Version{}, err
+	}
+
+	if !l.isOwner(value) {
+		return nil, store.Version{}, ErrNotHeld
+	}
+
+	return versionedStore, version, nil
+}
+
+// isOwner returns whether the given stored value is the token of this lock
+func (l *Lock) isOwner(value any) bool {
+	switch v := value.(type) {
+	case []byte:
+		return bytes.Equal(v, l.token)
+	case string:
+		return v == string(l.token)
+	}
+
+	return false
+}
+
+func newToken() ([]byte, error) {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return nil, err
+	}
+
+	return []byte(hex.EncodeToString(b)), nil
+}
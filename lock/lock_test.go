@@ -0,0 +1,185 @@
+package lock
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/eko/gocache/v3/store"
+	mocksStore "github.com/eko/gocache/v3/test/mocks/store"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+type lockStore struct {
+	*mocksStore.MockStoreInterface
+	*mocksStore.MockConditionalStoreInterface
+	*mocksStore.MockVersionedStoreInterface
+}
+
+func newLockStore(ctrl *gomock.Controller) *lockStore {
+	return &lockStore{
+		mocksStore.NewMockStoreInterface(ctrl),
+		mocksStore.NewMockConditionalStoreInterface(ctrl),
+		mocksStore.NewMockVersionedStoreInterface(ctrl),
+	}
+}
+
+func TestLockerAcquire(t *testing.T) {
+	// Given
+	ctrl := gomock.NewController(t)
+
+	ctx := context.Background()
+
+	lockStore := newLockStore(ctrl)
+	lockStore.MockConditionalStoreInterface.EXPECT().SetIfNotExists(ctx, "gocache_lock_my-key", gomock.Any(), store.OptionsMatcher{
+		Expiration: 10 * time.Second,
+	}).Return(nil)
+
+	locker := New(lockStore)
+
+	// When
+	lock, err := locker.Acquire(ctx, "my-key", 10*time.Second)
+
+	// Then
+	assert.Nil(t, err)
+	assert.Len(t, lock.Token(), 32)
+}
+
+func TestLockerAcquireWhenAlreadyHeld(t *testing.T) {
+	// Given
+	ctrl := gomock.NewController(t)
+
+	ctx := context.Background()
+
+	lockStore := newLockStore(ctrl)
+	lockStore.MockConditionalStoreInterface.EXPECT().SetIfNotExists(ctx, "gocache_lock_my-key", gomock.Any(), gomock.Any()).
+		Return(store.AlreadyExistsWithCause(errors.New("value already exists")))
+
+	locker := New(lockStore)
+
+	// When
+	lock, err := locker.Acquire(ctx, "my-key", 10*time.Second)
+
+	// Then
+	assert.Nil(t, lock)
+	assert.Equal(t, ErrNotAcquired, err)
+}
+
+func TestLockerAcquireWhenStoreNotSupported(t *testing.T) {
+	// Given
+	ctrl := gomock.NewController(t)
+
+	ctx := context.Background()
+
+	locker := New(mocksStore.NewMockStoreInterface(ctrl))
+
+	// When
+	lock, err := locker.Acquire(ctx, "my-key", 10*time.Second)
+
+	// Then
+	assert.Nil(t, lock)
+	assert.ErrorIs(t, err, store.ErrUnsupportedOperation)
+}
+
+func TestLockRenew(t *testing.T) {
+	// Given
+	ctrl := gomock.NewController(t)
+
+	ctx := context.Background()
+
+	version := store.Version{}
+
+	lockStore := newLockStore(ctrl)
+	lockStore.MockVersionedStoreInterface.EXPECT().GetWithVersion(ctx, "gocache_lock_my-key").Return("my-token", version, nil)
+	lockStore.MockVersionedStoreInterface.EXPECT().SetIfVersion(ctx, "gocache_lock_my-key", []byte("my-token"), version, store.OptionsMatcher{
+		Expiration: 20 * time.Second,
+	}).Return(nil)
+
+	lock := &Lock{store: lockStore, key: "gocache_lock_my-key", token: []byte("my-token")}
+
+	// When
+	err := lock.Renew(ctx, 20*time.Second)
+
+	// Then
+	assert.Nil(t, err)
+}
+
+func TestLockRenewWhenConflict(t *testing.T) {
+	// Given
+	ctrl := gomock.NewController(t)
+
+	ctx := context.Background()
+
+	lockStore := newLockStore(ctrl)
+	lockStore.MockVersionedStoreInterface.EXPECT().GetWithVersion(ctx, "gocache_lock_my-key").Return([]byte("my-token"), store.Version{}, nil)
+	lockStore.MockVersionedStoreInterface.EXPECT().SetIfVersion(ctx, "gocache_lock_my-key", gomock.Any(), gomock.Any(), gomock.Any()).
+		Return(store.VersionConflictWithCause(errors.New("value has changed")))
+
+	lock := &Lock{store: lockStore, key: "gocache_lock_my-key", token: []byte("my-token")}
+
+	// When
+	err := lock.Renew(ctx, 20*time.Second)
+
+	// Then
+	assert.Equal(t, ErrNotHeld, err)
+}
+
+func TestLockReleaseWhenOwnedByAnother(t *testing.T) {
+	// Given
+	ctrl := gomock.NewController(t)
+
+	ctx := context.Background()
+
+	lockStore := newLockStore(ctrl)
+	lockStore.MockVersionedStoreInterface.EXPECT().GetWithVersion(ctx, "gocache_lock_my-key").Return("other-token", store.Version{}, nil)
+
+	lock := &Lock{store: lockStore, key: "gocache_lock_my-key", token: []byte("my-token")}
+
+	// When
+	err := lock.Release(ctx)
+
+	// Then
+	assert.Equal(t, ErrNotHeld, err)
+}
+
+func TestLockReleaseWhenExpired(t *testing.T) {
+	// Given
+	ctrl := gomock.NewController(t)
+
+	ctx := context.Background()
+
+	lockStore := newLockStore(ctrl)
+	lockStore.MockVersionedStoreInterface.EXPECT().GetWithVersion(ctx, "gocache_lock_my-key").
+		Return(nil, store.Version{}, store.NotFoundWithCause(errors.New("value not found")))
+
+	lock := &Lock{store: lockStore, key: "gocache_lock_my-key", token: []byte("my-token")}
+
+	// When
+	err := lock.Release(ctx)
+
+	// Then
+	assert.Equal(t, ErrNotHeld, err)
+}
+
+func TestLockRelease(t *testing.T) {
+	// Given
+	ctrl := gomock.NewController(t)
+
+	ctx := context.Background()
+
+	version := store.Version{}
+
+	lockStore := newLockStore(ctrl)
+	lockStore.MockVersionedStoreInterface.EXPECT().GetWithVersion(ctx, "gocache_lock_my-key").Return([]byte("my-token"), version, nil)
+	lockStore.MockVersionedStoreInterface.EXPECT().DeleteIfVersion(ctx, "gocache_lock_my-key", version).Return(nil)
+
+	lock := &Lock{store: lockStore, key: "gocache_lock_my-key", token: []byte("my-token")}
+
+	// When
+	err := lock.Release(ctx)
+
+	// Then
+	assert.Nil(t, err)
+}
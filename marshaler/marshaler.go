@@ -65,3 +65,9 @@ func (c *Marshaler) Invalidate(ctx context.Context, options ...store.InvalidateO
 func (c *Marshaler) Clear(ctx context.Context) error {
 	return c.cache.Clear(ctx)
 }
+
+// GetComponents returns the wrapped cache, so that it is closed by
+// cache.CloseAll
+func (c *Marshaler) GetComponents() []any {
+	return []any{c.cache}
+}
@@ -1,6 +1,9 @@
 package metrics
 
 import (
+	"context"
+	"sync"
+
 	"github.com/eko/gocache/v3/codec"
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promauto"
@@ -16,7 +19,18 @@ var cacheCollector *prometheus.GaugeVec = initCacheCollector(namespaceCache)
 type Prometheus struct {
 	service      string
 	collector    *prometheus.GaugeVec
-	codecChannel chan codec.CodecInterface
+	codecChannel chan *recordItem
+	recorderWg   *sync.WaitGroup
+	closeMtx     sync.RWMutex
+	closed       bool
+}
+
+// recordItem is a codec whose statistics are to be recorded in background.
+// Items having a flushed channel only close it, once the items sent before
+// them have been recorded.
+type recordItem struct {
+	codec   codec.CodecInterface
+	flushed chan struct{}
 }
 
 func initCacheCollector(namespace string) *prometheus.GaugeVec {
@@ -36,9 +50,11 @@ func NewPrometheus(service string) *Prometheus {
 	prometheus := &Prometheus{
 		service:      service,
 		collector:    cacheCollector,
-		codecChannel: make(chan codec.CodecInterface, 10000),
+		codecChannel: make(chan *recordItem, 10000),
+		recorderWg:   &sync.WaitGroup{},
 	}
 
+	prometheus.recorderWg.Add(1)
 	go prometheus.recorder()
 
 	return prometheus
@@ -51,25 +67,92 @@ func (m *Prometheus) record(store, metric string, value float64) {
 
 // Recorder records metrics in prometheus by retrieving values from the codec channel
 func (m *Prometheus) recorder() {
-	for codec := range m.codecChannel {
-		stats := codec.GetStats()
-		storeType := codec.GetStore().GetType()
+	defer m.recorderWg.Done()
 
-		m.record(storeType, "hit_count", float64(stats.Hits))
-		m.record(storeType, "miss_count", float64(stats.Miss))
+	for item := range m.codecChannel {
+		if item.flushed != nil {
+			close(item.flushed)
+			continue
+		}
 
-		m.record(storeType, "set_success", float64(stats.SetSuccess))
-		m.record(storeType, "set_error", float64(stats.SetError))
+		m.recordCodec(item.codec)
+	}
+}
 
-		m.record(storeType, "delete_success", float64(stats.DeleteSuccess))
-		m.record(storeType, "delete_error", float64(stats.DeleteError))
+// recordCodec records the statistics of the given codec in prometheus
+func (m *Prometheus) recordCodec(codec codec.CodecInterface) {
+	stats := codec.GetStats()
+	storeType := codec.GetStore().GetType()
 
-		m.record(storeType, "invalidate_success", float64(stats.InvalidateSuccess))
-		m.record(storeType, "invalidate_error", float64(stats.InvalidateError))
-	}
+	m.record(storeType, "hit_count", float64(stats.Hits))
+	m.record(storeType, "miss_count", float64(stats.Miss))
+
+	m.record(storeType, "set_success", float64(stats.SetSuccess))
+	m.record(storeType, "set_error", float64(stats.SetError))
+
+	m.record(storeType, "delete_success", float64(stats.DeleteSuccess))
+	m.record(storeType, "delete_error", float64(stats.DeleteError))
+
+	m.record(storeType, "invalidate_success", float64(stats.InvalidateSuccess))
+	m.record(storeType, "invalidate_error", float64(stats.InvalidateError))
+
+	m.record(storeType, "touch_success", float64(stats.TouchSuccess))
+	m.record(storeType, "touch_error", float64(stats.TouchError))
 }
 
-// RecordFromCodec sends the given codec into the codec channel to be read from recorder
+// RecordFromCodec sends the given codec into the codec channel to be read from
+// recorder. Its statistics are recorded synchronously once closed.
 func (m *Prometheus) RecordFromCodec(codec codec.CodecInterface) {
-	m.codecChannel <- codec
+	m.closeMtx.RLock()
+	if !m.closed {
+		m.codecChannel <- &recordItem{codec: codec}
+		m.closeMtx.RUnlock()
+		return
+	}
+	m.closeMtx.RUnlock()
+
+	m.recordCodec(codec)
+}
+
+// Flush waits for the codecs sent before it to be recorded, or for the given
+// context to be done
+func (m *Prometheus) Flush(ctx context.Context) error {
+	flushed := make(chan struct{})
+
+	m.closeMtx.RLock()
+	if m.closed {
+		m.closeMtx.RUnlock()
+		return nil
+	}
+
+	select {
+	case m.codecChannel <- &recordItem{flushed: flushed}:
+		m.closeMtx.RUnlock()
+	case <-ctx.Done():
+		m.closeMtx.RUnlock()
+		return ctx.Err()
+	}
+
+	select {
+	case <-flushed:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// Close records the codecs sent into the codec channel and stops the recorder
+func (m *Prometheus) Close() error {
+	m.closeMtx.Lock()
+	defer m.closeMtx.Unlock()
+
+	if m.closed {
+		return nil
+	}
+	m.closed = true
+
+	close(m.codecChannel)
+	m.recorderWg.Wait()
+
+	return nil
 }
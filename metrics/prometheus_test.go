@@ -1,6 +1,7 @@
 package metrics
 
 import (
+	"context"
 	"testing"
 	"time"
 
@@ -60,6 +61,8 @@ func TestRecordFromCodec(t *testing.T) {
 		DeleteError:       5,
 		InvalidateSuccess: 2,
 		InvalidateError:   1,
+		TouchSuccess:      7,
+		TouchError:        9,
 	}
 
 	testCodec := mocksCodec.NewMockCodecInterface(ctrl)
@@ -113,6 +116,14 @@ func TestRecordFromCodec(t *testing.T) {
 			metricName: "invalidate_error",
 			expected:   float64(stats.InvalidateError),
 		},
+		{
+			metricName: "touch_success",
+			expected:   float64(stats.TouchSuccess),
+		},
+		{
+			metricName: "touch_error",
+			expected:   float64(stats.TouchError),
+		},
 	}
 
 	for _, tc := range testCases {
@@ -125,3 +136,70 @@ func TestRecordFromCodec(t *testing.T) {
 		assert.Equal(t, tc.expected, v)
 	}
 }
+
+func TestPrometheusFlushAndClose(t *testing.T) {
+	// Given
+	ctrl := gomock.NewController(t)
+
+	ctx := context.Background()
+
+	redisStore := mocksStore.NewMockStoreInterface(ctrl)
+	redisStore.EXPECT().GetType().Return("redis").Times(2)
+
+	testCodec := mocksCodec.NewMockCodecInterface(ctrl)
+	testCodec.EXPECT().GetStore().Return(redisStore).Times(2)
+	gomock.InOrder(
+		testCodec.EXPECT().GetStats().Return(&codec.Stats{Hits: 4}),
+		testCodec.EXPECT().GetStats().Return(&codec.Stats{Hits: 7}),
+	)
+
+	metrics := NewPrometheus("my-flushed-service-name")
+
+	// When
+	metrics.RecordFromCodec(testCodec)
+	err := metrics.Flush(ctx)
+
+	// Then
+	assert.Nil(t, err)
+	metric, err := metrics.collector.GetMetricWithLabelValues("my-flushed-service-name", "redis", "hit_count")
+	assert.Nil(t, err)
+	assert.Equal(t, float64(4), testutil.ToFloat64(metric))
+
+	// Statistics are recorded synchronously once closed
+	assert.Nil(t, metrics.Close())
+	assert.Nil(t, metrics.Close())
+	assert.Nil(t, metrics.Flush(ctx))
+
+	metrics.RecordFromCodec(testCodec)
+	assert.Equal(t, float64(7), testutil.ToFloat64(metric))
+}
+
+func TestPrometheusFlushWhenContextDone(t *testing.T) {
+	// Given
+	ctrl := gomock.NewController(t)
+
+	blocked := make(chan struct{})
+	defer close(blocked)
+
+	redisStore := mocksStore.NewMockStoreInterface(ctrl)
+	redisStore.EXPECT().GetType().Return("redis").AnyTimes()
+
+	testCodec := mocksCodec.NewMockCodecInterface(ctrl)
+	testCodec.EXPECT().GetStore().Return(redisStore).AnyTimes()
+	testCodec.EXPECT().GetStats().DoAndReturn(func() *codec.Stats {
+		<-blocked
+		return &codec.Stats{}
+	})
+
+	metrics := NewPrometheus("my-blocked-service-name")
+	metrics.RecordFromCodec(testCodec)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	// When
+	err := metrics.Flush(ctx)
+
+	// Then
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+}
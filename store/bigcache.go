@@ -4,8 +4,12 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"io"
 	"strings"
+	"sync"
 	"time"
+
+	"github.com/allegro/bigcache/v3"
 )
 
 // BigcacheClientInterface represents a allegro/bigcache client
@@ -14,6 +18,7 @@ type BigcacheClientInterface interface {
 	Set(key string, entry []byte) error
 	Delete(key string) error
 	Reset() error
+	Iterator() *bigcache.EntryInfoIterator
 }
 
 const (
@@ -25,21 +30,36 @@ const (
 
 // BigcacheStore is a store for Bigcache
 type BigcacheStore struct {
-	client  BigcacheClientInterface
-	options *options
+	// writeMu is held by all the writes so that the conditional ones, which
+	// read the current value before setting it, are atomic against them
+	writeMu  sync.Mutex
+	client   BigcacheClientInterface
+	options  *options
+	versions *keyVersions
 }
 
 // NewBigcache creates a new store to Bigcache instance(s)
 func NewBigcache(client BigcacheClientInterface, options ...Option) *BigcacheStore {
-	return &BigcacheStore{
+	store := &BigcacheStore{
 		client:  client,
 		options: applyOptions(options...),
 	}
+	store.versions = newKeyVersions(func(key any) bool {
+		item, err := client.Get(key.(string))
+		return err == nil && item != nil
+	})
+
+	return store
 }
 
 // Get returns data stored from a given key
 func (s *BigcacheStore) Get(_ context.Context, key any) (any, error) {
-	item, err := s.client.Get(key.(string))
+	k, err := keyString(key)
+	if err != nil {
+		return nil, err
+	}
+
+	item, err := s.client.Get(k)
 	if err != nil {
 		return nil, err
 	}
@@ -58,31 +78,40 @@ func (s *BigcacheStore) GetWithTTL(ctx context.Context, key any) (any, time.Dura
 
 // Set defines data in Bigcache for given key identifier
 func (s *BigcacheStore) Set(ctx context.Context, key any, value any, options ...Option) error {
+	s.writeMu.Lock()
+	defer s.writeMu.Unlock()
+
+	return s.set(ctx, key, value, options...)
+}
+
+// set defines data for the given key identifier. s.writeMu must be held.
+func (s *BigcacheStore) set(ctx context.Context, key any, value any, options ...Option) error {
 	opts := applyOptionsWithDefault(s.options, options...)
 
-	var val []byte
-	switch v := value.(type) {
-	case string:
-		val = []byte(v)
-	case []byte:
-		val = v
-	default:
-		return errors.New("value type not supported by Bigcache store")
+	k, err := keyString(key)
+	if err != nil {
+		return err
+	}
+
+	val, err := valueBytes(value)
+	if err != nil {
+		return err
 	}
 
-	err := s.client.Set(key.(string), val)
+	err = s.client.Set(k, val)
+	s.versions.forget(k)
 	if err != nil {
 		return err
 	}
 
 	if tags := opts.tags; len(tags) > 0 {
-		s.setTags(ctx, key, tags)
+		s.setTags(ctx, k, tags)
 	}
 
 	return nil
 }
 
-func (s *BigcacheStore) setTags(ctx context.Context, key any, tags []string) {
+func (s *BigcacheStore) setTags(ctx context.Context, key string, tags []string) {
 	for _, tag := range tags {
 		tagKey := fmt.Sprintf(BigcacheTagPattern, tag)
 		cacheKeys := []string{}
@@ -95,29 +124,183 @@ func (s *BigcacheStore) setTags(ctx context.Context, key any, tags []string) {
 
 		alreadyInserted := false
 		for _, cacheKey := range cacheKeys {
-			if cacheKey == key.(string) {
+			if cacheKey == key {
 				alreadyInserted = true
 				break
 			}
 		}
 
 		if !alreadyInserted {
-			cacheKeys = append(cacheKeys, key.(string))
+			cacheKeys = append(cacheKeys, key)
 		}
 
-		s.Set(ctx, tagKey, []byte(strings.Join(cacheKeys, ",")), WithExpiration(720*time.Hour))
+		s.set(ctx, tagKey, []byte(strings.Join(cacheKeys, ",")), WithExpiration(720*time.Hour))
 	}
 }
 
 // Delete removes data from Bigcache for given key identifier
 func (s *BigcacheStore) Delete(_ context.Context, key any) error {
-	return s.client.Delete(key.(string))
+	k, err := keyString(key)
+	if err != nil {
+		return err
+	}
+
+	s.writeMu.Lock()
+	defer s.writeMu.Unlock()
+
+	err = s.delete(k)
+	if errors.Is(err, bigcache.ErrEntryNotFound) {
+		return NotFoundWithCause(err)
+	}
+
+	return err
+}
+
+// delete removes the given key. s.writeMu must be held.
+func (s *BigcacheStore) delete(k string) error {
+	err := s.client.Delete(k)
+	s.versions.forget(k)
+
+	return err
+}
+
+// SetIfNotExists defines data in Bigcache for given key identifier only when
+// the key does not exist yet
+func (s *BigcacheStore) SetIfNotExists(ctx context.Context, key any, value any, options ...Option) error {
+	s.writeMu.Lock()
+	defer s.writeMu.Unlock()
+
+	k, err := keyString(key)
+	if err != nil {
+		return err
+	}
+
+	if item, err := s.client.Get(k); err == nil && item != nil {
+		return AlreadyExistsWithCause(errors.New("value already exists in Bigcache store"))
+	}
+
+	return s.set(ctx, key, value, options...)
+}
+
+// GetWithVersion returns data stored from a given key and its version, which
+// changes every time the key is written
+func (s *BigcacheStore) GetWithVersion(ctx context.Context, key any) (any, Version, error) {
+	k, err := keyString(key)
+	if err != nil {
+		return nil, Version{}, err
+	}
+
+	version := s.versions.get(k)
+
+	value, err := s.Get(ctx, key)
+	if err != nil {
+		return nil, Version{}, err
+	}
+
+	return value, version, nil
+}
+
+// SetIfVersion defines data in Bigcache for given key identifier only when it
+// has not been written since the given version was read
+func (s *BigcacheStore) SetIfVersion(ctx context.Context, key any, value any, version Version, options ...Option) error {
+	s.writeMu.Lock()
+	defer s.writeMu.Unlock()
+
+	k, err := keyString(key)
+	if err != nil {
+		return err
+	}
+
+	if item, err := s.client.Get(k); err != nil || item == nil || !s.versions.isCurrent(k, version) {
+		return VersionConflictWithCause(errors.New("value has changed in Bigcache store"))
+	}
+
+	return s.set(ctx, key, value, options...)
+}
+
+// DeleteIfVersion removes data from Bigcache for given key identifier only when
+// it has not been written since the given version was read
+func (s *BigcacheStore) DeleteIfVersion(ctx context.Context, key any, version Version) error {
+	s.writeMu.Lock()
+	defer s.writeMu.Unlock()
+
+	k, err := keyString(key)
+	if err != nil {
+		return err
+	}
+
+	if item, err := s.client.Get(k); err != nil || item == nil || !s.versions.isCurrent(k, version) {
+		return VersionConflictWithCause(errors.New("value has changed in Bigcache store"))
+	}
+
+	return s.delete(k)
+}
+
+// Exists returns whether the given key exists in Bigcache
+func (s *BigcacheStore) Exists(_ context.Context, key any) (bool, error) {
+	k, err := keyString(key)
+	if err != nil {
+		return false, err
+	}
+
+	item, err := s.client.Get(k)
+	return err == nil && item != nil, nil
+}
+
+// Increment adds delta to the integer stored at the given key. Missing keys
+// are considered as 0. Bigcache only supports a global expiration so the
+// expiration option is ignored.
+func (s *BigcacheStore) Increment(_ context.Context, key any, delta int64, _ ...Option) (int64, error) {
+	s.writeMu.Lock()
+	defer s.writeMu.Unlock()
+
+	k, err := keyString(key)
+	if err != nil {
+		return 0, err
+	}
+
+	counter := delta
+
+	if value, err := s.client.Get(k); err == nil && value != nil {
+		current, err := CounterValue(value)
+		if err != nil {
+			return 0, err
+		}
+		counter += current
+	}
+
+	err = s.client.Set(k, FormatCounter(counter))
+	s.versions.forget(k)
+	if err != nil {
+		return 0, err
+	}
+
+	return counter, nil
 }
 
 // Invalidate invalidates some cache data in Bigcache for given options
 func (s *BigcacheStore) Invalidate(ctx context.Context, options ...InvalidateOption) error {
 	opts := applyInvalidateOptions(options...)
 
+	if pattern := opts.pattern; pattern != "" {
+		deleted, err := deleteMatching(ctx, s, pattern, 0, func(_ context.Context, keys []string) (int64, error) {
+			s.writeMu.Lock()
+			defer s.writeMu.Unlock()
+
+			var deleted int64
+			for _, key := range keys {
+				if err := s.delete(key); err == nil {
+					deleted++
+				}
+			}
+			return deleted, nil
+		})
+		opts.addDeleted(deleted)
+		if err != nil {
+			return err
+		}
+	}
+
 	if tags := opts.tags; len(tags) > 0 {
 		for _, tag := range tags {
 			tagKey := fmt.Sprintf(BigcacheTagPattern, tag)
@@ -140,9 +323,53 @@ func (s *BigcacheStore) Invalidate(ctx context.Context, options ...InvalidateOpt
 	return nil
 }
 
+// Scan calls fn for each key matching the given pattern using the Bigcache
+// iterator. Entries removed during the scan are skipped.
+func (s *BigcacheStore) Scan(ctx context.Context, pattern string, fn func(key string) error) error {
+	iterator := s.client.Iterator()
+
+	for iterator.SetNext() {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		entry, err := iterator.Value()
+		if errors.Is(err, bigcache.ErrCannotRetrieveEntry) {
+			continue
+		} else if err != nil {
+			return err
+		}
+
+		if !MatchPattern(pattern, entry.Key()) {
+			continue
+		}
+
+		if err := fn(entry.Key()); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 // Clear resets all data in the store
 func (s *BigcacheStore) Clear(_ context.Context) error {
-	return s.client.Reset()
+	s.writeMu.Lock()
+	defer s.writeMu.Unlock()
+
+	err := s.client.Reset()
+	s.versions.forgetAll()
+
+	return err
+}
+
+// Close closes the Bigcache client, when it can be closed
+func (s *BigcacheStore) Close() error {
+	if closer, ok := s.client.(io.Closer); ok {
+		return closer.Close()
+	}
+
+	return nil
 }
 
 // GetType returns the store type
@@ -6,6 +6,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/allegro/bigcache/v3"
 	mocksStore "github.com/eko/gocache/v3/test/mocks/store/clients"
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/assert"
@@ -251,6 +252,25 @@ func TestBigcacheDelete(t *testing.T) {
 	assert.Nil(t, err)
 }
 
+func TestBigcacheDeleteWhenNotFound(t *testing.T) {
+	// Given
+	ctrl := gomock.NewController(t)
+
+	ctx := context.Background()
+
+	client := mocksStore.NewMockBigcacheClientInterface(ctrl)
+	client.EXPECT().Delete("my-key").Return(bigcache.ErrEntryNotFound)
+
+	store := NewBigcache(client)
+
+	// When
+	err := store.Delete(ctx, "my-key")
+
+	// Then
+	assert.ErrorIs(t, err, &NotFound{})
+	assert.ErrorIs(t, err, bigcache.ErrEntryNotFound)
+}
+
 func TestBigcacheDeleteWhenError(t *testing.T) {
 	// Given
 	ctrl := gomock.NewController(t)
@@ -273,6 +293,44 @@ func TestBigcacheDeleteWhenError(t *testing.T) {
 	assert.Equal(t, expectedErr, err)
 }
 
+func TestBigcacheSetIfNotExistsWhenAlreadyExists(t *testing.T) {
+	// Given
+	ctrl := gomock.NewController(t)
+
+	ctx := context.Background()
+
+	client := mocksStore.NewMockBigcacheClientInterface(ctrl)
+	client.EXPECT().Get("my-key").Return([]byte("other-value"), nil)
+
+	store := NewBigcache(client)
+
+	// When
+	err := store.SetIfNotExists(ctx, "my-key", []byte("my-value"))
+
+	// Then
+	assert.ErrorIs(t, err, &AlreadyExists{})
+}
+
+func TestBigcacheIncrement(t *testing.T) {
+	// Given
+	ctrl := gomock.NewController(t)
+
+	ctx := context.Background()
+
+	client := mocksStore.NewMockBigcacheClientInterface(ctrl)
+	client.EXPECT().Get("my-counter").Return([]byte("10"), nil)
+	client.EXPECT().Set("my-counter", []byte("15")).Return(nil)
+
+	store := NewBigcache(client)
+
+	// When
+	counter, err := store.Increment(ctx, "my-counter", 5)
+
+	// Then
+	assert.Nil(t, err)
+	assert.Equal(t, int64(15), counter)
+}
+
 func TestBigcacheInvalidate(t *testing.T) {
 	// Given
 	ctrl := gomock.NewController(t)
@@ -317,6 +375,61 @@ func TestBigcacheInvalidateWhenError(t *testing.T) {
 	assert.Nil(t, err)
 }
 
+func TestBigcacheSetIfVersionWhenValueIsSetBack(t *testing.T) {
+	// Given
+	ctx := context.Background()
+
+	bigcacheClient, err := bigcache.NewBigCache(bigcache.DefaultConfig(time.Minute))
+	assert.Nil(t, err)
+
+	store := NewBigcache(bigcacheClient)
+	assert.Nil(t, store.Set(ctx, "my-key", []byte("value 1")))
+
+	_, version, err := store.GetWithVersion(ctx, "my-key")
+	assert.Nil(t, err)
+
+	assert.Nil(t, store.Set(ctx, "my-key", []byte("value 2")))
+	assert.Nil(t, store.Set(ctx, "my-key", []byte("value 1")))
+
+	// When
+	err = store.SetIfVersion(ctx, "my-key", []byte("value 3"), version)
+
+	// Then
+	assert.ErrorIs(t, err, &VersionConflict{})
+
+	_, version, err = store.GetWithVersion(ctx, "my-key")
+	assert.Nil(t, err)
+	assert.Nil(t, store.SetIfVersion(ctx, "my-key", []byte("value 3"), version))
+}
+
+func TestBigcacheScan(t *testing.T) {
+	// Given
+	ctrl := gomock.NewController(t)
+
+	ctx := context.Background()
+
+	bigcacheClient, _ := bigcache.NewBigCache(bigcache.DefaultConfig(time.Minute))
+	bigcacheClient.Set("my-key1", []byte("my-value1"))
+	bigcacheClient.Set("my-key2", []byte("my-value2"))
+	bigcacheClient.Set("other-key", []byte("other-value"))
+
+	client := mocksStore.NewMockBigcacheClientInterface(ctrl)
+	client.EXPECT().Iterator().Return(bigcacheClient.Iterator())
+
+	store := NewBigcache(client)
+
+	// When
+	var keys []string
+	err := store.Scan(ctx, "my-*", func(key string) error {
+		keys = append(keys, key)
+		return nil
+	})
+
+	// Then
+	assert.Nil(t, err)
+	assert.ElementsMatch(t, []string{"my-key1", "my-key2"}, keys)
+}
+
 func TestBigcacheClear(t *testing.T) {
 	// Given
 	ctrl := gomock.NewController(t)
@@ -0,0 +1,33 @@
+package store
+
+import (
+	"fmt"
+)
+
+// keyString returns the string form of the given key. Byte slices and
+// fmt.Stringer implementations are converted, other types are not supported.
+func keyString(key any) (string, error) {
+	switch k := key.(type) {
+	case string:
+		return k, nil
+	case []byte:
+		return string(k), nil
+	case fmt.Stringer:
+		return k.String(), nil
+	}
+
+	return "", fmt.Errorf("%w: %T", ErrUnsupportedKeyType, key)
+}
+
+// valueBytes returns the given value as bytes for stores handling bytes only.
+// Strings are converted, other types are not supported.
+func valueBytes(value any) ([]byte, error) {
+	switch v := value.(type) {
+	case []byte:
+		return v, nil
+	case string:
+		return []byte(v), nil
+	}
+
+	return nil, fmt.Errorf("%w: %T", ErrUnsupportedValueType, value)
+}
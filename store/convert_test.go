@@ -0,0 +1,156 @@
+package store
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	mocksStore "github.com/eko/gocache/v3/test/mocks/store/clients"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+type convertTestKey struct {
+	ID int
+}
+
+type convertTestStringer int
+
+func (k convertTestStringer) String() string {
+	return "stringer-key"
+}
+
+func TestKeyString(t *testing.T) {
+	testCases := []struct {
+		key         any
+		expected    string
+		expectedErr error
+	}{
+		{key: "my-key", expected: "my-key"},
+		{key: []byte("my-key"), expected: "my-key"},
+		{key: convertTestStringer(1), expected: "stringer-key"},
+		{key: 42, expectedErr: ErrUnsupportedKeyType},
+		{key: convertTestKey{ID: 42}, expectedErr: ErrUnsupportedKeyType},
+		{key: nil, expectedErr: ErrUnsupportedKeyType},
+	}
+
+	for _, tc := range testCases {
+		// When
+		key, err := keyString(tc.key)
+
+		// Then
+		assert.ErrorIs(t, err, tc.expectedErr)
+		assert.Equal(t, tc.expected, key)
+	}
+}
+
+func TestValueBytes(t *testing.T) {
+	testCases := []struct {
+		value       any
+		expected    []byte
+		expectedErr error
+	}{
+		{value: []byte("my-value"), expected: []byte("my-value")},
+		{value: "my-value", expected: []byte("my-value")},
+		{value: 42, expectedErr: ErrUnsupportedValueType},
+		{value: map[string]string{"a": "b"}, expectedErr: ErrUnsupportedValueType},
+	}
+
+	for _, tc := range testCases {
+		// When
+		value, err := valueBytes(tc.value)
+
+		// Then
+		assert.ErrorIs(t, err, tc.expectedErr)
+		assert.Equal(t, tc.expected, value)
+	}
+}
+
+// TestStoresUnsupportedTypes checks that every store returns typed errors
+// without calling its client when given keys or values it cannot handle
+func TestStoresUnsupportedTypes(t *testing.T) {
+	testCases := []struct {
+		name string
+		// anyValue is set for in-memory stores holding values of any type
+		anyValue bool
+		store    func(ctrl *gomock.Controller) StoreInterface
+	}{
+		{name: "bigcache", store: func(ctrl *gomock.Controller) StoreInterface {
+			return NewBigcache(mocksStore.NewMockBigcacheClientInterface(ctrl))
+		}},
+		{name: "freecache", store: func(ctrl *gomock.Controller) StoreInterface {
+			return NewFreecache(mocksStore.NewMockFreecacheClientInterface(ctrl))
+		}},
+		{name: "go-cache", anyValue: true, store: func(ctrl *gomock.Controller) StoreInterface {
+			return NewGoCache(mocksStore.NewMockGoCacheClientInterface(ctrl))
+		}},
+		{name: "memcache", store: func(ctrl *gomock.Controller) StoreInterface {
+			return NewMemcache(mocksStore.NewMockMemcacheClientInterface(ctrl))
+		}},
+		{name: "pegasus", store: func(_ *gomock.Controller) StoreInterface {
+			return &PegasusStore{options: testPegasusOptions()}
+		}},
+		{name: "redis", store: func(ctrl *gomock.Controller) StoreInterface {
+			return NewRedis(mocksStore.NewMockRedisClientInterface(ctrl))
+		}},
+		{name: "rediscluster", store: func(ctrl *gomock.Controller) StoreInterface {
+			return NewRedisCluster(mocksStore.NewMockRedisClusterClientInterface(ctrl))
+		}},
+		{name: "ristretto", anyValue: true, store: func(ctrl *gomock.Controller) StoreInterface {
+			return NewRistretto(mocksStore.NewMockRistrettoClientInterface(ctrl))
+		}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// Given
+			ctrl := gomock.NewController(t)
+
+			ctx := context.Background()
+
+			s := tc.store(ctrl)
+			key := convertTestKey{ID: 42}
+			value := map[string]string{"a": "b"}
+
+			// When - Then
+			_, err := s.Get(ctx, key)
+			assert.ErrorIs(t, err, ErrUnsupportedKeyType)
+
+			_, _, err = s.GetWithTTL(ctx, key)
+			assert.ErrorIs(t, err, ErrUnsupportedKeyType)
+
+			assert.ErrorIs(t, s.Set(ctx, key, []byte("my-value")), ErrUnsupportedKeyType)
+			assert.ErrorIs(t, s.Delete(ctx, key), ErrUnsupportedKeyType)
+
+			if !tc.anyValue {
+				assert.ErrorIs(t, s.Set(ctx, "my-key", value), ErrUnsupportedValueType)
+			}
+
+			if bulkStore, ok := s.(BulkStoreInterface); ok {
+				_, err = bulkStore.GetMany(ctx, []any{key})
+				assert.ErrorIs(t, err, ErrUnsupportedKeyType)
+
+				assert.ErrorIs(t, bulkStore.SetMany(ctx, map[any]any{key: []byte("my-value")}), ErrUnsupportedKeyType)
+				assert.ErrorIs(t, bulkStore.DeleteMany(ctx, []any{key}), ErrUnsupportedKeyType)
+			}
+
+			if conditionalStore, ok := s.(ConditionalStoreInterface); ok {
+				assert.ErrorIs(t, conditionalStore.SetIfNotExists(ctx, key, []byte("my-value")), ErrUnsupportedKeyType)
+			}
+
+			if existsStore, ok := s.(ExistsStoreInterface); ok {
+				_, err = existsStore.Exists(ctx, key)
+				assert.ErrorIs(t, err, ErrUnsupportedKeyType)
+			}
+
+			if touchStore, ok := s.(TouchStoreInterface); ok {
+				assert.ErrorIs(t, touchStore.Touch(ctx, key, time.Minute), ErrUnsupportedKeyType)
+			}
+
+			if counterStore, ok := s.(CounterStoreInterface); ok {
+				_, err = counterStore.Increment(ctx, key, 1)
+				assert.ErrorIs(t, err, ErrUnsupportedKeyType)
+			}
+		})
+	}
+}
@@ -0,0 +1,36 @@
+package store
+
+import (
+	"strconv"
+)
+
+// CounterValue returns the integer held by a counter value read from a store
+func CounterValue(value any) (int64, error) {
+	switch v := value.(type) {
+	case int64:
+		return v, nil
+	case int:
+		return int64(v), nil
+	case []byte:
+		return parseCounter(string(v))
+	case string:
+		return parseCounter(v)
+	}
+
+	return 0, ErrNotInteger
+}
+
+func parseCounter(value string) (int64, error) {
+	counter, err := strconv.ParseInt(value, 10, 64)
+	if err != nil {
+		return 0, ErrNotInteger
+	}
+
+	return counter, nil
+}
+
+// FormatCounter returns the representation of a counter in stores handling
+// bytes only, which is understood by the increments of all the stores
+func FormatCounter(counter int64) []byte {
+	return []byte(strconv.FormatInt(counter, 10))
+}
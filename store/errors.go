@@ -1,5 +1,7 @@
 package store
 
+import "errors"
+
 const NOT_FOUND_ERR string = "value not found in store"
 
 type NotFound struct {
@@ -25,3 +27,78 @@ func (e NotFound) Error() string {
 	return NOT_FOUND_ERR
 }
 func (e NotFound) Unwrap() error { return e.cause }
+
+const ALREADY_EXISTS_ERR string = "value already exists in store"
+
+type AlreadyExists struct {
+	cause error
+}
+
+func AlreadyExistsWithCause(e error) error {
+	err := AlreadyExists{
+		cause: e,
+	}
+	return &err
+}
+
+func (e AlreadyExists) Cause() error {
+	return e.cause
+}
+
+func (e AlreadyExists) Is(err error) bool {
+	return err.Error() == ALREADY_EXISTS_ERR
+}
+
+func (e AlreadyExists) Error() string {
+	return ALREADY_EXISTS_ERR
+}
+func (e AlreadyExists) Unwrap() error { return e.cause }
+
+const VERSION_CONFLICT_ERR string = "value version has changed in store"
+
+type VersionConflict struct {
+	cause error
+}
+
+func VersionConflictWithCause(e error) error {
+	err := VersionConflict{
+		cause: e,
+	}
+	return &err
+}
+
+func (e VersionConflict) Cause() error {
+	return e.cause
+}
+
+func (e VersionConflict) Is(err error) bool {
+	return err.Error() == VERSION_CONFLICT_ERR
+}
+
+func (e VersionConflict) Error() string {
+	return VERSION_CONFLICT_ERR
+}
+func (e VersionConflict) Unwrap() error { return e.cause }
+
+// ErrUnsupportedOperation is returned when calling an operation that is not
+// supported by the underlying store
+var ErrUnsupportedOperation = errors.New("operation not supported by store")
+
+// ErrInvalidVersion is returned when giving a version that has not been
+// returned by the store
+var ErrInvalidVersion = errors.New("version not issued by store")
+
+// ErrNotInteger is returned when incrementing a value that is not an integer
+var ErrNotInteger = errors.New("value is not an integer")
+
+// ErrNamespaceRequired is returned when clearing a shared store which has
+// neither a namespace nor the flush all option
+var ErrNamespaceRequired = errors.New("namespace or flush all option required to clear store")
+
+// ErrUnsupportedKeyType is returned when giving a key whose type cannot be
+// converted to a key of the underlying store
+var ErrUnsupportedKeyType = errors.New("key type not supported by store")
+
+// ErrUnsupportedValueType is returned when giving a value whose type cannot be
+// stored by the underlying store
+var ErrUnsupportedValueType = errors.New("value type not supported by store")
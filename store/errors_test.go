@@ -4,6 +4,7 @@ import (
 	"errors"
 	"testing"
 
+	"github.com/bradfitz/gomemcache/memcache"
 	"github.com/go-redis/redis/v8"
 	"github.com/stretchr/testify/assert"
 )
@@ -21,3 +22,24 @@ func TestNotFoundIs(t *testing.T) {
 
 	assert.True(t, err.Error() == NotFound{}.Error())
 }
+
+func TestAlreadyExistsIs(t *testing.T) {
+	err := AlreadyExistsWithCause(memcache.ErrNotStored)
+	assert.True(t, errors.Is(err, AlreadyExists{}))
+	assert.True(t, errors.Is(err, memcache.ErrNotStored))
+	assert.False(t, errors.Is(err, NotFound{}))
+
+	_, ok := err.(*AlreadyExists)
+	assert.True(t, ok)
+
+	assert.True(t, err.Error() == AlreadyExists{}.Error())
+}
+
+func TestVersionConflictIs(t *testing.T) {
+	err := VersionConflictWithCause(memcache.ErrCASConflict)
+	assert.True(t, errors.Is(err, VersionConflict{}))
+	assert.True(t, errors.Is(err, memcache.ErrCASConflict))
+	assert.False(t, errors.Is(err, AlreadyExists{}))
+
+	assert.True(t, err.Error() == VersionConflict{}.Error())
+}
@@ -5,7 +5,10 @@ import (
 	"errors"
 	"fmt"
 	"strings"
+	"sync"
 	"time"
+
+	"github.com/coocood/freecache"
 )
 
 const (
@@ -20,59 +23,72 @@ type FreecacheClientInterface interface {
 	Get(key []byte) (value []byte, err error)
 	GetInt(key int64) (value []byte, err error)
 	TTL(key []byte) (timeLeft uint32, err error)
+	Touch(key []byte, expireSeconds int) (err error)
 	Set(key, value []byte, expireSeconds int) (err error)
 	SetInt(key int64, value []byte, expireSeconds int) (err error)
 	Del(key []byte) (affected bool)
 	DelInt(key int64) (affected bool)
 	Clear()
+	NewIterator() *freecache.Iterator
 }
 
 // FreecacheStore is a store for freecache
 type FreecacheStore struct {
-	client  FreecacheClientInterface
-	options *options
+	// writeMu is held by all the writes so that the conditional ones, which
+	// read the current value before setting it, are atomic against them
+	writeMu  sync.Mutex
+	client   FreecacheClientInterface
+	options  *options
+	versions *keyVersions
 }
 
 // NewFreecache creates a new store to freecache instance(s)
 func NewFreecache(client FreecacheClientInterface, options ...Option) *FreecacheStore {
-	return &FreecacheStore{
+	store := &FreecacheStore{
 		client:  client,
 		options: applyOptions(options...),
 	}
+	store.versions = newKeyVersions(func(key any) bool {
+		_, err := client.Get([]byte(key.(string)))
+		return err == nil
+	})
+
+	return store
 }
 
 // Get returns data stored from a given key. It returns the value or not found error
 func (f *FreecacheStore) Get(_ context.Context, key any) (any, error) {
-	var err error
-	var result any
-	if k, ok := key.(string); ok {
-		result, err = f.client.Get([]byte(k))
-		if err != nil {
-			return nil, NotFoundWithCause(errors.New("value not found in Freecache store"))
-		}
-		return result, err
+	k, err := keyString(key)
+	if err != nil {
+		return nil, err
 	}
 
-	return nil, errors.New("key type not supported by Freecache store")
+	result, err := f.client.Get([]byte(k))
+	if err != nil {
+		return nil, NotFoundWithCause(errors.New("value not found in Freecache store"))
+	}
+
+	return result, nil
 }
 
 // GetWithTTL returns data stored from a given key and its corresponding TTL
 func (f *FreecacheStore) GetWithTTL(_ context.Context, key any) (any, time.Duration, error) {
-	if k, ok := key.(string); ok {
-		result, err := f.client.Get([]byte(k))
-		if err != nil {
-			return nil, 0, NotFoundWithCause(errors.New("value not found in Freecache store"))
-		}
+	k, err := keyString(key)
+	if err != nil {
+		return nil, 0, err
+	}
 
-		ttl, err := f.client.TTL([]byte(k))
-		if err != nil {
-			return nil, 0, NotFoundWithCause(errors.New("value not found in Freecache store"))
-		}
+	result, err := f.client.Get([]byte(k))
+	if err != nil {
+		return nil, 0, NotFoundWithCause(errors.New("value not found in Freecache store"))
+	}
 
-		return result, time.Duration(ttl) * time.Second, err
+	ttl, err := f.client.TTL([]byte(k))
+	if err != nil {
+		return nil, 0, NotFoundWithCause(errors.New("value not found in Freecache store"))
 	}
 
-	return nil, 0, errors.New("key type not supported by Freecache store")
+	return result, time.Duration(ttl) * time.Second, nil
 }
 
 // Set sets a key, value and expiration for a cache entry and stores it in the cache.
@@ -80,51 +96,57 @@ func (f *FreecacheStore) GetWithTTL(_ context.Context, key any) (any, time.Durat
 // the entry will not be written to the cache. expireSeconds <= 0 means no expire,
 // but it can be evicted when cache is full.
 func (f *FreecacheStore) Set(ctx context.Context, key any, value any, options ...Option) error {
-	var err error
-	var val []byte
+	f.writeMu.Lock()
+	defer f.writeMu.Unlock()
 
+	return f.set(ctx, key, value, options...)
+}
+
+// set defines data for the given key identifier. f.writeMu must be held.
+func (f *FreecacheStore) set(ctx context.Context, key any, value any, options ...Option) error {
 	// Using default options set during cache initialization
 	opts := applyOptionsWithDefault(f.options, options...)
 
-	// type check for value, as freecache only supports value of type []byte
-	switch v := value.(type) {
-	case []byte:
-		val = v
-	default:
-		return errors.New("value type not supported by Freecache store")
+	k, err := keyString(key)
+	if err != nil {
+		return err
 	}
 
-	if k, ok := key.(string); ok {
-		err = f.client.Set([]byte(k), val, int(opts.expiration.Seconds()))
-		if err != nil {
-			return fmt.Errorf("size of key: %v, value: %v, err: %v", k, len(val), err)
-		}
-		if tags := opts.tags; len(tags) > 0 {
-			f.setTags(ctx, key, tags)
-		}
-		return nil
+	// freecache only supports values of type []byte
+	val, err := valueBytes(value)
+	if err != nil {
+		return err
+	}
+
+	err = f.client.Set([]byte(k), val, int(opts.expiration.Seconds()))
+	f.versions.forget(k)
+	if err != nil {
+		return fmt.Errorf("size of key: %v, value: %v, err: %v", k, len(val), err)
 	}
-	return errors.New("key type not supported by Freecache store")
+	if tags := opts.tags; len(tags) > 0 {
+		f.setTags(ctx, k, tags)
+	}
+	return nil
 }
 
-func (f *FreecacheStore) setTags(ctx context.Context, key any, tags []string) {
+func (f *FreecacheStore) setTags(ctx context.Context, key string, tags []string) {
 	for _, tag := range tags {
 		tagKey := fmt.Sprintf(FreecacheTagPattern, tag)
 		cacheKeys := f.getCacheKeysForTag(ctx, tagKey)
 
 		alreadyInserted := false
 		for _, cacheKey := range cacheKeys {
-			if cacheKey == key.(string) {
+			if cacheKey == key {
 				alreadyInserted = true
 				break
 			}
 		}
 
 		if !alreadyInserted {
-			cacheKeys = append(cacheKeys, key.(string))
+			cacheKeys = append(cacheKeys, key)
 		}
 
-		f.Set(ctx, tagKey, []byte(strings.Join(cacheKeys, ",")), WithExpiration(720*time.Hour))
+		f.set(ctx, tagKey, []byte(strings.Join(cacheKeys, ",")), WithExpiration(720*time.Hour))
 	}
 }
 
@@ -138,21 +160,193 @@ func (f *FreecacheStore) getCacheKeysForTag(ctx context.Context, tagKey string)
 	return cacheKeys
 }
 
-// Delete deletes an item in the cache by key and returns err or nil if a delete occurred
+// Delete deletes an item in the cache by key and returns err or nil if a delete occurred.
+// A NotFound error is returned when the key does not exist.
 func (f *FreecacheStore) Delete(_ context.Context, key any) error {
-	if v, ok := key.(string); ok {
-		if f.client.Del([]byte(v)) {
-			return nil
+	k, err := keyString(key)
+	if err != nil {
+		return err
+	}
+
+	f.writeMu.Lock()
+	defer f.writeMu.Unlock()
+
+	if f.delete(k) {
+		return nil
+	}
+	return NotFoundWithCause(fmt.Errorf("failed to delete key %v", key))
+}
+
+// delete removes the given key and returns whether it existed. f.writeMu must
+// be held.
+func (f *FreecacheStore) delete(k string) bool {
+	affected := f.client.Del([]byte(k))
+	f.versions.forget(k)
+
+	return affected
+}
+
+// SetIfNotExists defines data in freecache for given key identifier only when
+// the key does not exist yet
+func (f *FreecacheStore) SetIfNotExists(ctx context.Context, key any, value any, options ...Option) error {
+	k, err := keyString(key)
+	if err != nil {
+		return err
+	}
+
+	f.writeMu.Lock()
+	defer f.writeMu.Unlock()
+
+	if _, err := f.client.Get([]byte(k)); err == nil {
+		return AlreadyExistsWithCause(errors.New("value already exists in Freecache store"))
+	}
+
+	return f.set(ctx, key, value, options...)
+}
+
+// GetWithVersion returns data stored from a given key and its version, which
+// changes every time the key is written
+func (f *FreecacheStore) GetWithVersion(ctx context.Context, key any) (any, Version, error) {
+	k, err := keyString(key)
+	if err != nil {
+		return nil, Version{}, err
+	}
+
+	version := f.versions.get(k)
+
+	value, err := f.Get(ctx, key)
+	if err != nil {
+		return nil, Version{}, err
+	}
+
+	return value, version, nil
+}
+
+// SetIfVersion defines data in freecache for given key identifier only when it
+// has not been written since the given version was read
+func (f *FreecacheStore) SetIfVersion(ctx context.Context, key any, value any, version Version, options ...Option) error {
+	k, err := keyString(key)
+	if err != nil {
+		return err
+	}
+
+	f.writeMu.Lock()
+	defer f.writeMu.Unlock()
+
+	if _, err := f.client.Get([]byte(k)); err != nil || !f.versions.isCurrent(k, version) {
+		return VersionConflictWithCause(errors.New("value has changed in Freecache store"))
+	}
+
+	return f.set(ctx, key, value, options...)
+}
+
+// DeleteIfVersion removes data from freecache for given key identifier only when
+// it has not been written since the given version was read
+func (f *FreecacheStore) DeleteIfVersion(ctx context.Context, key any, version Version) error {
+	k, err := keyString(key)
+	if err != nil {
+		return err
+	}
+
+	f.writeMu.Lock()
+	defer f.writeMu.Unlock()
+
+	if _, err := f.client.Get([]byte(k)); err != nil || !f.versions.isCurrent(k, version) {
+		return VersionConflictWithCause(errors.New("value has changed in Freecache store"))
+	}
+
+	f.delete(k)
+
+	return nil
+}
+
+// Exists returns whether the given key exists in freecache
+func (f *FreecacheStore) Exists(_ context.Context, key any) (bool, error) {
+	k, err := keyString(key)
+	if err != nil {
+		return false, err
+	}
+
+	_, err = f.client.Get([]byte(k))
+	return err == nil, nil
+}
+
+// Touch updates the expiration of the given key in freecache
+func (f *FreecacheStore) Touch(_ context.Context, key any, ttl time.Duration) error {
+	k, err := keyString(key)
+	if err != nil {
+		return err
+	}
+
+	if err := f.client.Touch([]byte(k), int(ttl.Seconds())); err != nil {
+		return NotFoundWithCause(err)
+	}
+	return nil
+}
+
+// Increment adds delta to the integer stored at the given key. Missing keys
+// are considered as 0 and created using the expiration option, while existing
+// ones keep their remaining TTL.
+func (f *FreecacheStore) Increment(_ context.Context, key any, delta int64, options ...Option) (int64, error) {
+	k, err := keyString(key)
+	if err != nil {
+		return 0, err
+	}
+
+	f.writeMu.Lock()
+	defer f.writeMu.Unlock()
+
+	opts := applyOptionsWithDefault(f.options, options...)
+
+	counter := delta
+	expireSeconds := int(opts.expiration.Seconds())
+
+	if value, err := f.client.Get([]byte(k)); err == nil {
+		current, err := CounterValue(value)
+		if err != nil {
+			return 0, err
 		}
-		return fmt.Errorf("failed to delete key %v", key)
+		counter += current
+
+		ttl, err := f.client.TTL([]byte(k))
+		if err != nil {
+			return 0, err
+		}
+		expireSeconds = int(ttl)
 	}
-	return errors.New("key type not supported by Freecache store")
+
+	err = f.client.Set([]byte(k), FormatCounter(counter), expireSeconds)
+	f.versions.forget(k)
+	if err != nil {
+		return 0, err
+	}
+
+	return counter, nil
 }
 
 // Invalidate invalidates some cache data in freecache for given options
 func (f *FreecacheStore) Invalidate(ctx context.Context, options ...InvalidateOption) error {
 	opts := applyInvalidateOptions(options...)
 
+	if pattern := opts.pattern; pattern != "" {
+		deleted, err := deleteMatching(ctx, f, pattern, 0, func(_ context.Context, keys []string) (int64, error) {
+			f.writeMu.Lock()
+			defer f.writeMu.Unlock()
+
+			var deleted int64
+			for _, key := range keys {
+				if f.delete(key) {
+					deleted++
+				}
+			}
+			return deleted, nil
+		})
+		opts.addDeleted(deleted)
+		if err != nil {
+			return err
+		}
+	}
+
 	if tags := opts.tags; len(tags) > 0 {
 		for _, tag := range tags {
 			tagKey := fmt.Sprintf(FreecacheTagPattern, tag)
@@ -175,9 +369,36 @@ func (f *FreecacheStore) Invalidate(ctx context.Context, options ...InvalidateOp
 	return nil
 }
 
+// Scan calls fn for each key matching the given pattern using the freecache
+// iterator
+func (f *FreecacheStore) Scan(ctx context.Context, pattern string, fn func(key string) error) error {
+	iterator := f.client.NewIterator()
+
+	for entry := iterator.Next(); entry != nil; entry = iterator.Next() {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		key := string(entry.Key)
+		if !MatchPattern(pattern, key) {
+			continue
+		}
+
+		if err := fn(key); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 // Clear resets all data in the store
 func (f *FreecacheStore) Clear(_ context.Context) error {
+	f.writeMu.Lock()
+	defer f.writeMu.Unlock()
+
 	f.client.Clear()
+	f.versions.forgetAll()
 	return nil
 }
 
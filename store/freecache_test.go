@@ -7,6 +7,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/coocood/freecache"
 	mocksStore "github.com/eko/gocache/v3/test/mocks/store/clients"
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/assert"
@@ -91,8 +92,8 @@ func TestFreecacheGetWithInvalidKey(t *testing.T) {
 
 	s := NewFreecache(client)
 
-	value, err := s.Get(ctx, []byte("key1"))
-	assert.Error(t, err, "key type not supported by Freecache store")
+	value, err := s.Get(ctx, 1)
+	assert.ErrorIs(t, err, ErrUnsupportedKeyType)
 	assert.Nil(t, value)
 }
 
@@ -176,8 +177,8 @@ func TestFreecacheGetWithTTLWhenInvalidKey(t *testing.T) {
 
 	s := NewFreecache(client)
 
-	value, ttl, err := s.GetWithTTL(ctx, []byte("key1"))
-	assert.Error(t, err, "key type not supported by Freecache store")
+	value, ttl, err := s.GetWithTTL(ctx, 1)
+	assert.ErrorIs(t, err, ErrUnsupportedKeyType)
 	assert.Nil(t, value)
 	assert.Equal(t, 0*time.Second, ttl)
 }
@@ -223,14 +224,13 @@ func TestFreecacheSetInvalidValue(t *testing.T) {
 	ctx := context.Background()
 
 	cacheKey := "my-key"
-	cacheValue := "my-cache-value"
-	expectedErr := errors.New("value type not supported by Freecache store")
+	cacheValue := 42
 
 	client := mocksStore.NewMockFreecacheClientInterface(ctrl)
 
 	s := NewFreecache(client, WithExpiration(6*time.Second))
 	err := s.Set(ctx, cacheKey, cacheValue, WithExpiration(6*time.Second))
-	assert.Equal(t, expectedErr, err)
+	assert.ErrorIs(t, err, ErrUnsupportedValueType)
 }
 
 func TestFreecacheSetInvalidSize(t *testing.T) {
@@ -259,13 +259,11 @@ func TestFreecacheSetInvalidKey(t *testing.T) {
 	cacheKey := 1
 	cacheValue := []byte("my-cache-value")
 
-	expectedErr := errors.New("key type not supported by Freecache store")
-
 	client := mocksStore.NewMockFreecacheClientInterface(ctrl)
 
 	s := NewFreecache(client, WithExpiration(6*time.Second))
 	err := s.Set(ctx, cacheKey, cacheValue, WithExpiration(6*time.Second))
-	assert.Equal(t, expectedErr, err)
+	assert.ErrorIs(t, err, ErrUnsupportedKeyType)
 }
 
 func TestFreecacheDelete(t *testing.T) {
@@ -297,7 +295,8 @@ func TestFreecacheDeleteFailed(t *testing.T) {
 
 	s := NewFreecache(client)
 	err := s.Delete(ctx, cacheKey)
-	assert.Equal(t, expectedErr, err)
+	assert.ErrorIs(t, err, &NotFound{})
+	assert.Equal(t, expectedErr, errors.Unwrap(err))
 }
 
 func TestFreecacheDeleteInvalidKey(t *testing.T) {
@@ -307,12 +306,11 @@ func TestFreecacheDeleteInvalidKey(t *testing.T) {
 	ctx := context.Background()
 
 	cacheKey := 1
-	expectedErr := errors.New("key type not supported by Freecache store")
 	client := mocksStore.NewMockFreecacheClientInterface(ctrl)
 
 	s := NewFreecache(client)
 	err := s.Delete(ctx, cacheKey)
-	assert.Equal(t, expectedErr, err)
+	assert.ErrorIs(t, err, ErrUnsupportedKeyType)
 }
 
 func TestFreecacheSetWithTags(t *testing.T) {
@@ -334,6 +332,122 @@ func TestFreecacheSetWithTags(t *testing.T) {
 	assert.Nil(t, err)
 }
 
+func TestFreecacheSetIfNotExists(t *testing.T) {
+	// Given
+	ctrl := gomock.NewController(t)
+
+	ctx := context.Background()
+
+	client := mocksStore.NewMockFreecacheClientInterface(ctrl)
+	client.EXPECT().Get([]byte("my-key")).Return(nil, errors.New("entry not found"))
+	client.EXPECT().Set([]byte("my-key"), []byte("my-value"), 60).Return(nil)
+
+	s := NewFreecache(client, WithExpiration(time.Minute))
+
+	// When
+	err := s.SetIfNotExists(ctx, "my-key", []byte("my-value"))
+
+	// Then
+	assert.Nil(t, err)
+}
+
+func TestFreecacheSetIfVersionWhenConflict(t *testing.T) {
+	// Given
+	ctrl := gomock.NewController(t)
+
+	ctx := context.Background()
+
+	client := mocksStore.NewMockFreecacheClientInterface(ctrl)
+	client.EXPECT().Get([]byte("my-key")).Return([]byte("value 3"), nil)
+
+	s := NewFreecache(client)
+
+	// When
+	err := s.SetIfVersion(ctx, "my-key", []byte("value 2"), Version{token: []byte("value 1")})
+
+	// Then
+	assert.ErrorIs(t, err, &VersionConflict{})
+}
+
+func TestFreecacheSetIfVersionWhenValueIsSetBack(t *testing.T) {
+	// Given
+	ctx := context.Background()
+
+	s := NewFreecache(freecache.NewCache(1024 * 1024))
+	assert.Nil(t, s.Set(ctx, "my-key", []byte("value 1")))
+
+	_, version, err := s.GetWithVersion(ctx, "my-key")
+	assert.Nil(t, err)
+
+	assert.Nil(t, s.Set(ctx, "my-key", []byte("value 2")))
+	assert.Nil(t, s.Set(ctx, "my-key", []byte("value 1")))
+
+	// When
+	err = s.SetIfVersion(ctx, "my-key", []byte("value 3"), version)
+
+	// Then
+	assert.ErrorIs(t, err, &VersionConflict{})
+}
+
+func TestFreecacheTouch(t *testing.T) {
+	// Given
+	ctrl := gomock.NewController(t)
+
+	ctx := context.Background()
+
+	client := mocksStore.NewMockFreecacheClientInterface(ctrl)
+	client.EXPECT().Touch([]byte("my-key"), 10).Return(nil)
+
+	s := NewFreecache(client)
+
+	// When
+	err := s.Touch(ctx, "my-key", 10*time.Second)
+
+	// Then
+	assert.Nil(t, err)
+}
+
+func TestFreecacheIncrementWhenMissing(t *testing.T) {
+	// Given
+	ctrl := gomock.NewController(t)
+
+	ctx := context.Background()
+
+	client := mocksStore.NewMockFreecacheClientInterface(ctrl)
+	client.EXPECT().Get([]byte("my-counter")).Return(nil, errors.New("entry not found"))
+	client.EXPECT().Set([]byte("my-counter"), []byte("1"), 60).Return(nil)
+
+	s := NewFreecache(client, WithExpiration(time.Minute))
+
+	// When
+	counter, err := s.Increment(ctx, "my-counter", 1)
+
+	// Then
+	assert.Nil(t, err)
+	assert.Equal(t, int64(1), counter)
+}
+
+func TestFreecacheIncrementWhenExisting(t *testing.T) {
+	// Given
+	ctrl := gomock.NewController(t)
+
+	ctx := context.Background()
+
+	client := mocksStore.NewMockFreecacheClientInterface(ctrl)
+	client.EXPECT().Get([]byte("my-counter")).Return([]byte("41"), nil)
+	client.EXPECT().TTL([]byte("my-counter")).Return(uint32(12), nil)
+	client.EXPECT().Set([]byte("my-counter"), []byte("42"), 12).Return(nil)
+
+	s := NewFreecache(client, WithExpiration(time.Minute))
+
+	// When
+	counter, err := s.Increment(ctx, "my-counter", 1)
+
+	// Then
+	assert.Nil(t, err)
+	assert.Equal(t, int64(42), counter)
+}
+
 func TestFreecacheInvalidate(t *testing.T) {
 	// Given
 	ctrl := gomock.NewController(t)
@@ -440,7 +554,8 @@ func TestFreecacheFailedInvalidateMultipleKeys(t *testing.T) {
 	err := s.Invalidate(ctx, WithInvalidateTags([]string{"tag1"}))
 
 	// Then
-	assert.EqualError(t, err, "failed to delete key my-key")
+	assert.ErrorIs(t, err, &NotFound{})
+	assert.EqualError(t, errors.Unwrap(err), "failed to delete key my-key")
 }
 
 func TestFreecacheFailedInvalidatePattern(t *testing.T) {
@@ -464,7 +579,61 @@ func TestFreecacheFailedInvalidatePattern(t *testing.T) {
 	err := s.Invalidate(ctx, WithInvalidateTags([]string{"tag1"}))
 
 	// Then
-	assert.EqualError(t, err, "failed to delete key freecache_tag_tag1")
+	assert.ErrorIs(t, err, &NotFound{})
+	assert.EqualError(t, errors.Unwrap(err), "failed to delete key freecache_tag_tag1")
+}
+
+func TestFreecacheInvalidatePattern(t *testing.T) {
+	// Given
+	ctrl := gomock.NewController(t)
+
+	ctx := context.Background()
+
+	freecacheClient := freecache.NewCache(1024 * 1024)
+	freecacheClient.Set([]byte("user:42:name"), []byte("John"), 0)
+	freecacheClient.Set([]byte("user:43:name"), []byte("Jane"), 0)
+
+	client := mocksStore.NewMockFreecacheClientInterface(ctrl)
+	client.EXPECT().NewIterator().Return(freecacheClient.NewIterator())
+	client.EXPECT().Del([]byte("user:42:name")).Return(true)
+
+	s := NewFreecache(client)
+
+	// When
+	var deleted int64
+	err := s.Invalidate(ctx, WithInvalidatePattern("user:42:*"), WithInvalidateDeletedCount(&deleted))
+
+	// Then
+	assert.Nil(t, err)
+	assert.Equal(t, int64(1), deleted)
+}
+
+func TestFreecacheScan(t *testing.T) {
+	// Given
+	ctrl := gomock.NewController(t)
+
+	ctx := context.Background()
+
+	freecacheClient := freecache.NewCache(1024 * 1024)
+	freecacheClient.Set([]byte("my-key1"), []byte("my-value1"), 0)
+	freecacheClient.Set([]byte("my-key2"), []byte("my-value2"), 0)
+	freecacheClient.Set([]byte("other-key"), []byte("other-value"), 0)
+
+	client := mocksStore.NewMockFreecacheClientInterface(ctrl)
+	client.EXPECT().NewIterator().Return(freecacheClient.NewIterator())
+
+	s := NewFreecache(client)
+
+	// When
+	var keys []string
+	err := s.Scan(ctx, "my-*", func(key string) error {
+		keys = append(keys, key)
+		return nil
+	})
+
+	// Then
+	assert.Nil(t, err)
+	assert.ElementsMatch(t, []string{"my-key1", "my-key2"}, keys)
 }
 
 func TestFreecacheClearAll(t *testing.T) {
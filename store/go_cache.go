@@ -6,6 +6,8 @@ import (
 	"fmt"
 	"sync"
 	"time"
+
+	"github.com/patrickmn/go-cache"
 )
 
 const (
@@ -22,28 +24,42 @@ type GoCacheClientInterface interface {
 	Set(k string, x any, d time.Duration)
 	Delete(k string)
 	Flush()
+	Items() map[string]cache.Item
 }
 
 // GoCacheStore is a store for GoCache (memory) library
 type GoCacheStore struct {
-	mu      sync.RWMutex
-	client  GoCacheClientInterface
-	options *options
+	mu sync.RWMutex
+	// writeMu is held by all the writes so that the conditional ones, which
+	// read the current value before setting it, are atomic against them
+	writeMu  sync.Mutex
+	client   GoCacheClientInterface
+	options  *options
+	versions *keyVersions
 }
 
 // NewGoCache creates a new store to GoCache (memory) library instance
 func NewGoCache(client GoCacheClientInterface, options ...Option) *GoCacheStore {
-	return &GoCacheStore{
+	store := &GoCacheStore{
 		client:  client,
 		options: applyOptions(options...),
 	}
+	store.versions = newKeyVersions(func(key any) bool {
+		_, exists := client.Get(key.(string))
+		return exists
+	})
+
+	return store
 }
 
 // Get returns data stored from a given key
 func (s *GoCacheStore) Get(_ context.Context, key any) (any, error) {
-	var err error
-	keyStr := key.(string)
-	value, exists := s.client.Get(keyStr)
+	k, err := keyString(key)
+	if err != nil {
+		return nil, err
+	}
+
+	value, exists := s.client.Get(k)
 	if !exists {
 		err = NotFoundWithCause(errors.New("value not found in GoCache store"))
 	}
@@ -53,7 +69,12 @@ func (s *GoCacheStore) Get(_ context.Context, key any) (any, error) {
 
 // GetWithTTL returns data stored from a given key and its corresponding TTL
 func (s *GoCacheStore) GetWithTTL(_ context.Context, key any) (any, time.Duration, error) {
-	data, t, exists := s.client.GetWithExpiration(key.(string))
+	k, err := keyString(key)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	data, t, exists := s.client.GetWithExpiration(k)
 	if !exists {
 		return data, 0, NotFoundWithCause(errors.New("value not found in GoCache store"))
 	}
@@ -63,21 +84,32 @@ func (s *GoCacheStore) GetWithTTL(_ context.Context, key any) (any, time.Duratio
 
 // Set defines data in GoCache memoey cache for given key identifier
 func (s *GoCacheStore) Set(ctx context.Context, key any, value any, options ...Option) error {
-	opts := applyOptions(options...)
-	if opts == nil {
-		opts = s.options
+	k, err := keyString(key)
+	if err != nil {
+		return err
 	}
 
-	s.client.Set(key.(string), value, opts.expiration)
+	s.writeMu.Lock()
+	defer s.writeMu.Unlock()
 
-	if tags := opts.tags; len(tags) > 0 {
-		s.setTags(ctx, key, tags)
-	}
+	s.set(ctx, k, value, options...)
 
 	return nil
 }
 
-func (s *GoCacheStore) setTags(ctx context.Context, key any, tags []string) {
+// set defines data for the given key identifier. s.writeMu must be held.
+func (s *GoCacheStore) set(ctx context.Context, k string, value any, options ...Option) {
+	opts := applyOptionsWithDefault(s.options, options...)
+
+	s.client.Set(k, value, opts.expiration)
+	s.versions.forget(k)
+
+	if tags := opts.tags; len(tags) > 0 {
+		s.setTags(ctx, k, tags)
+	}
+}
+
+func (s *GoCacheStore) setTags(ctx context.Context, key string, tags []string) {
 	for _, tag := range tags {
 		tagKey := fmt.Sprintf(GoCacheTagPattern, tag)
 		var cacheKeys map[string]struct{}
@@ -89,7 +121,7 @@ func (s *GoCacheStore) setTags(ctx context.Context, key any, tags []string) {
 		}
 
 		s.mu.RLock()
-		if _, exists := cacheKeys[key.(string)]; exists {
+		if _, exists := cacheKeys[key]; exists {
 			s.mu.RUnlock()
 			continue
 		}
@@ -100,23 +132,203 @@ func (s *GoCacheStore) setTags(ctx context.Context, key any, tags []string) {
 		}
 
 		s.mu.Lock()
-		cacheKeys[key.(string)] = struct{}{}
+		cacheKeys[key] = struct{}{}
 		s.mu.Unlock()
 
 		s.client.Set(tagKey, cacheKeys, 720*time.Hour)
+		s.versions.forget(tagKey)
 	}
 }
 
 // Delete removes data in GoCache memoey cache for given key identifier
 func (s *GoCacheStore) Delete(_ context.Context, key any) error {
-	s.client.Delete(key.(string))
+	k, err := keyString(key)
+	if err != nil {
+		return err
+	}
+
+	s.writeMu.Lock()
+	defer s.writeMu.Unlock()
+
+	s.client.Delete(k)
+	s.versions.forget(k)
+
 	return nil
 }
 
+// SetIfNotExists defines data in GoCache memory cache for given key identifier
+// only when the key does not exist yet
+func (s *GoCacheStore) SetIfNotExists(ctx context.Context, key any, value any, options ...Option) error {
+	k, err := keyString(key)
+	if err != nil {
+		return err
+	}
+
+	s.writeMu.Lock()
+	defer s.writeMu.Unlock()
+
+	if _, exists := s.client.Get(k); exists {
+		return AlreadyExistsWithCause(errors.New("value already exists in GoCache store"))
+	}
+
+	s.set(ctx, k, value, options...)
+
+	return nil
+}
+
+// GetWithVersion returns data stored from a given key and its version, which
+// changes every time the key is written
+func (s *GoCacheStore) GetWithVersion(_ context.Context, key any) (any, Version, error) {
+	k, err := keyString(key)
+	if err != nil {
+		return nil, Version{}, err
+	}
+
+	version := s.versions.get(k)
+
+	value, exists := s.client.Get(k)
+	if !exists {
+		return nil, Version{}, NotFoundWithCause(errors.New("value not found in GoCache store"))
+	}
+
+	return value, version, nil
+}
+
+// SetIfVersion defines data in GoCache memory cache for given key identifier only when it
+// has not been written since the given version was read
+func (s *GoCacheStore) SetIfVersion(ctx context.Context, key any, value any, version Version, options ...Option) error {
+	k, err := keyString(key)
+	if err != nil {
+		return err
+	}
+
+	s.writeMu.Lock()
+	defer s.writeMu.Unlock()
+
+	if _, exists := s.client.Get(k); !exists || !s.versions.isCurrent(k, version) {
+		return VersionConflictWithCause(errors.New("value has changed in GoCache store"))
+	}
+
+	s.set(ctx, k, value, options...)
+
+	return nil
+}
+
+// DeleteIfVersion removes data from GoCache memory cache for given key identifier only when
+// it has not been written since the given version was read
+func (s *GoCacheStore) DeleteIfVersion(ctx context.Context, key any, version Version) error {
+	k, err := keyString(key)
+	if err != nil {
+		return err
+	}
+
+	s.writeMu.Lock()
+	defer s.writeMu.Unlock()
+
+	if _, exists := s.client.Get(k); !exists || !s.versions.isCurrent(k, version) {
+		return VersionConflictWithCause(errors.New("value has changed in GoCache store"))
+	}
+
+	s.client.Delete(k)
+	s.versions.forget(k)
+
+	return nil
+}
+
+// Exists returns whether the given key exists in GoCache memory cache
+func (s *GoCacheStore) Exists(_ context.Context, key any) (bool, error) {
+	k, err := keyString(key)
+	if err != nil {
+		return false, err
+	}
+
+	_, exists := s.client.Get(k)
+	return exists, nil
+}
+
+// Touch updates the expiration of the given key in GoCache memory cache by
+// setting its value again
+func (s *GoCacheStore) Touch(_ context.Context, key any, ttl time.Duration) error {
+	k, err := keyString(key)
+	if err != nil {
+		return err
+	}
+
+	s.writeMu.Lock()
+	defer s.writeMu.Unlock()
+
+	value, exists := s.client.Get(k)
+	if !exists {
+		return NotFoundWithCause(errors.New("value not found in GoCache store"))
+	}
+
+	// -1 means no expiration for go-cache while 0 means its default expiration
+	if ttl <= 0 {
+		ttl = -1
+	}
+	s.client.Set(k, value, ttl)
+
+	return nil
+}
+
+// Increment adds delta to the integer stored at the given key. Missing keys
+// are considered as 0 and created using the expiration option, while existing
+// ones keep their expiration.
+func (s *GoCacheStore) Increment(_ context.Context, key any, delta int64, options ...Option) (int64, error) {
+	k, err := keyString(key)
+	if err != nil {
+		return 0, err
+	}
+
+	s.writeMu.Lock()
+	defer s.writeMu.Unlock()
+
+	value, t, exists := s.client.GetWithExpiration(k)
+	if !exists {
+		opts := applyOptionsWithDefault(s.options, options...)
+		s.client.Set(k, delta, opts.expiration)
+		s.versions.forget(k)
+		return delta, nil
+	}
+
+	counter, err := CounterValue(value)
+	if err != nil {
+		return 0, err
+	}
+	counter += delta
+
+	// -1 means no expiration for go-cache while 0 means its default expiration
+	expiration := time.Duration(-1)
+	if !t.IsZero() {
+		expiration = time.Until(t)
+	}
+	s.client.Set(k, counter, expiration)
+	s.versions.forget(k)
+
+	return counter, nil
+}
+
 // Invalidate invalidates some cache data in GoCache memoey cache for given options
 func (s *GoCacheStore) Invalidate(ctx context.Context, options ...InvalidateOption) error {
 	opts := applyInvalidateOptions(options...)
 
+	if pattern := opts.pattern; pattern != "" {
+		deleted, err := deleteMatching(ctx, s, pattern, 0, func(_ context.Context, keys []string) (int64, error) {
+			s.writeMu.Lock()
+			defer s.writeMu.Unlock()
+
+			for _, key := range keys {
+				s.client.Delete(key)
+				s.versions.forget(key)
+			}
+			return int64(len(keys)), nil
+		})
+		opts.addDeleted(deleted)
+		if err != nil {
+			return err
+		}
+	}
+
 	if tags := opts.tags; len(tags) > 0 {
 		for _, tag := range tags {
 			tagKey := fmt.Sprintf(GoCacheTagPattern, tag)
@@ -130,11 +342,14 @@ func (s *GoCacheStore) Invalidate(ctx context.Context, options ...InvalidateOpti
 				cacheKeys = bytes
 			}
 
+			s.writeMu.Lock()
 			s.mu.RLock()
 			for cacheKey := range cacheKeys {
-				_ = s.Delete(ctx, cacheKey)
+				s.client.Delete(cacheKey)
+				s.versions.forget(cacheKey)
 			}
 			s.mu.RUnlock()
+			s.writeMu.Unlock()
 		}
 	}
 
@@ -146,8 +361,32 @@ func (s *GoCacheStore) GetType() string {
 	return GoCacheType
 }
 
+// Scan calls fn for each key matching the given pattern, among a snapshot of
+// the items which are not expired
+func (s *GoCacheStore) Scan(ctx context.Context, pattern string, fn func(key string) error) error {
+	for key := range s.client.Items() {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		if !MatchPattern(pattern, key) {
+			continue
+		}
+
+		if err := fn(key); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 // Clear resets all data in the store
 func (s *GoCacheStore) Clear(_ context.Context) error {
+	s.writeMu.Lock()
+	defer s.writeMu.Unlock()
+
 	s.client.Flush()
+	s.versions.forgetAll()
 	return nil
 }
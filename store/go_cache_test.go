@@ -3,6 +3,8 @@ package store
 import (
 	"context"
 	"fmt"
+	"math"
+	"sync"
 	"testing"
 	"time"
 
@@ -224,6 +226,251 @@ func TestGoCacheDelete(t *testing.T) {
 	assert.Nil(t, err)
 }
 
+func TestGoCacheSetIfNotExists(t *testing.T) {
+	// Given
+	ctrl := gomock.NewController(t)
+
+	ctx := context.Background()
+
+	client := mocksStore.NewMockGoCacheClientInterface(ctrl)
+	client.EXPECT().Get("my-key").Return(nil, false)
+	client.EXPECT().Set("my-key", "my-value", 5*time.Second)
+
+	store := NewGoCache(client)
+
+	// When
+	err := store.SetIfNotExists(ctx, "my-key", "my-value", WithExpiration(5*time.Second))
+
+	// Then
+	assert.Nil(t, err)
+}
+
+func TestGoCacheSetIfNotExistsWhenAlreadyExists(t *testing.T) {
+	// Given
+	ctrl := gomock.NewController(t)
+
+	ctx := context.Background()
+
+	client := mocksStore.NewMockGoCacheClientInterface(ctrl)
+	client.EXPECT().Get("my-key").Return("other-value", true)
+
+	store := NewGoCache(client)
+
+	// When
+	err := store.SetIfNotExists(ctx, "my-key", "my-value")
+
+	// Then
+	assert.ErrorIs(t, err, &AlreadyExists{})
+}
+
+func TestGoCacheSetIfVersion(t *testing.T) {
+	// Given
+	ctrl := gomock.NewController(t)
+
+	ctx := context.Background()
+
+	client := mocksStore.NewMockGoCacheClientInterface(ctrl)
+	client.EXPECT().Get("my-key").Return("value 1", true).Times(2)
+	client.EXPECT().Set("my-key", "value 2", time.Duration(0))
+
+	store := NewGoCache(client)
+
+	_, version, err := store.GetWithVersion(ctx, "my-key")
+	assert.Nil(t, err)
+
+	// When
+	err = store.SetIfVersion(ctx, "my-key", "value 2", version)
+
+	// Then
+	assert.Nil(t, err)
+}
+
+func TestGoCacheSetIfVersionWhenConflict(t *testing.T) {
+	// Given
+	ctrl := gomock.NewController(t)
+
+	ctx := context.Background()
+
+	client := mocksStore.NewMockGoCacheClientInterface(ctrl)
+	client.EXPECT().Get("my-key").Return("value 1", true)
+	client.EXPECT().Set("my-key", "value 3", time.Duration(0))
+	client.EXPECT().Get("my-key").Return("value 3", true)
+
+	store := NewGoCache(client)
+
+	_, version, err := store.GetWithVersion(ctx, "my-key")
+	assert.Nil(t, err)
+	assert.Nil(t, store.Set(ctx, "my-key", "value 3"))
+
+	// When
+	err = store.SetIfVersion(ctx, "my-key", "value 2", version)
+
+	// Then
+	assert.ErrorIs(t, err, &VersionConflict{})
+}
+
+func TestGoCacheSetIfVersionWhenValueIsSetBack(t *testing.T) {
+	// Given
+	ctx := context.Background()
+
+	store := NewGoCache(cache.New(10*time.Second, 30*time.Second))
+	assert.Nil(t, store.Set(ctx, "my-key", "value 1"))
+
+	_, version, err := store.GetWithVersion(ctx, "my-key")
+	assert.Nil(t, err)
+
+	assert.Nil(t, store.Set(ctx, "my-key", "value 2"))
+	assert.Nil(t, store.Set(ctx, "my-key", "value 1"))
+
+	// When
+	err = store.SetIfVersion(ctx, "my-key", "value 3", version)
+
+	// Then
+	assert.ErrorIs(t, err, &VersionConflict{})
+}
+
+func TestGoCacheSetIfVersionWhenValueIsMutatedAndSetAgain(t *testing.T) {
+	// Given
+	ctx := context.Background()
+
+	type session struct {
+		Visits int
+	}
+
+	store := NewGoCache(cache.New(10*time.Second, 30*time.Second))
+	assert.Nil(t, store.Set(ctx, "my-key", &session{}))
+
+	value, version, err := store.GetWithVersion(ctx, "my-key")
+	assert.Nil(t, err)
+
+	value.(*session).Visits++
+	assert.Nil(t, store.Set(ctx, "my-key", value))
+
+	// When
+	err = store.SetIfVersion(ctx, "my-key", &session{}, version)
+
+	// Then
+	assert.ErrorIs(t, err, &VersionConflict{})
+}
+
+func TestGoCacheSetIfVersionWhenValueIsNotComparable(t *testing.T) {
+	// Given
+	ctx := context.Background()
+
+	type session struct {
+		Score    float64
+		Callback func()
+	}
+
+	store := NewGoCache(cache.New(10*time.Second, 30*time.Second))
+	assert.Nil(t, store.Set(ctx, "my-key", session{Score: math.NaN(), Callback: func() {}}))
+
+	_, version, err := store.GetWithVersion(ctx, "my-key")
+	assert.Nil(t, err)
+
+	// When
+	err = store.SetIfVersion(ctx, "my-key", session{}, version)
+
+	// Then
+	assert.Nil(t, err)
+}
+
+func TestGoCacheTouch(t *testing.T) {
+	// Given
+	ctrl := gomock.NewController(t)
+
+	ctx := context.Background()
+
+	client := mocksStore.NewMockGoCacheClientInterface(ctrl)
+	client.EXPECT().Get("my-key").Return("my-value", true)
+	client.EXPECT().Set("my-key", "my-value", 10*time.Second)
+
+	store := NewGoCache(client)
+
+	// When
+	err := store.Touch(ctx, "my-key", 10*time.Second)
+
+	// Then
+	assert.Nil(t, err)
+}
+
+func TestGoCacheTouchWhenNotFound(t *testing.T) {
+	// Given
+	ctrl := gomock.NewController(t)
+
+	ctx := context.Background()
+
+	client := mocksStore.NewMockGoCacheClientInterface(ctrl)
+	client.EXPECT().Get("my-key").Return(nil, false)
+
+	store := NewGoCache(client)
+
+	// When
+	err := store.Touch(ctx, "my-key", 10*time.Second)
+
+	// Then
+	assert.ErrorIs(t, err, &NotFound{})
+}
+
+func TestGoCacheIncrementWhenMissing(t *testing.T) {
+	// Given
+	ctrl := gomock.NewController(t)
+
+	ctx := context.Background()
+
+	client := mocksStore.NewMockGoCacheClientInterface(ctrl)
+	client.EXPECT().GetWithExpiration("my-counter").Return(nil, time.Time{}, false)
+	client.EXPECT().Set("my-counter", int64(3), 5*time.Second)
+
+	store := NewGoCache(client)
+
+	// When
+	counter, err := store.Increment(ctx, "my-counter", 3, WithExpiration(5*time.Second))
+
+	// Then
+	assert.Nil(t, err)
+	assert.Equal(t, int64(3), counter)
+}
+
+func TestGoCacheIncrementWhenExisting(t *testing.T) {
+	// Given
+	ctrl := gomock.NewController(t)
+
+	ctx := context.Background()
+
+	client := mocksStore.NewMockGoCacheClientInterface(ctrl)
+	client.EXPECT().GetWithExpiration("my-counter").Return(int64(3), time.Time{}, true)
+	client.EXPECT().Set("my-counter", int64(1), time.Duration(-1))
+
+	store := NewGoCache(client)
+
+	// When
+	counter, err := store.Increment(ctx, "my-counter", -2, WithExpiration(5*time.Second))
+
+	// Then
+	assert.Nil(t, err)
+	assert.Equal(t, int64(1), counter)
+}
+
+func TestGoCacheIncrementWhenNotInteger(t *testing.T) {
+	// Given
+	ctrl := gomock.NewController(t)
+
+	ctx := context.Background()
+
+	client := mocksStore.NewMockGoCacheClientInterface(ctrl)
+	client.EXPECT().GetWithExpiration("my-counter").Return("my-value", time.Time{}, true)
+
+	store := NewGoCache(client)
+
+	// When
+	counter, err := store.Increment(ctx, "my-counter", 1)
+
+	// Then
+	assert.Equal(t, ErrNotInteger, err)
+	assert.Equal(t, int64(0), counter)
+}
+
 func TestGoCacheInvalidate(t *testing.T) {
 	// Given
 	ctrl := gomock.NewController(t)
@@ -266,6 +513,59 @@ func TestGoCacheInvalidateWhenError(t *testing.T) {
 	assert.Nil(t, err)
 }
 
+func TestGoCacheInvalidatePrefix(t *testing.T) {
+	// Given
+	ctrl := gomock.NewController(t)
+
+	ctx := context.Background()
+
+	client := mocksStore.NewMockGoCacheClientInterface(ctrl)
+	client.EXPECT().Items().Return(map[string]cache.Item{
+		"user:42:name":  {Object: "John"},
+		"user:42:email": {Object: "john@example.com"},
+		"user:43:name":  {Object: "Jane"},
+	})
+	client.EXPECT().Delete("user:42:name")
+	client.EXPECT().Delete("user:42:email")
+
+	store := NewGoCache(client)
+
+	// When
+	var deleted int64
+	err := store.Invalidate(ctx, WithInvalidatePrefix("user:42:"), WithInvalidateDeletedCount(&deleted))
+
+	// Then
+	assert.Nil(t, err)
+	assert.Equal(t, int64(2), deleted)
+}
+
+func TestGoCacheScan(t *testing.T) {
+	// Given
+	ctrl := gomock.NewController(t)
+
+	ctx := context.Background()
+
+	client := mocksStore.NewMockGoCacheClientInterface(ctrl)
+	client.EXPECT().Items().Return(map[string]cache.Item{
+		"my-key1":   {Object: "my-value1"},
+		"my-key2":   {Object: "my-value2"},
+		"other-key": {Object: "other-value"},
+	})
+
+	store := NewGoCache(client)
+
+	// When
+	var keys []string
+	err := store.Scan(ctx, "my-*", func(key string) error {
+		keys = append(keys, key)
+		return nil
+	})
+
+	// Then
+	assert.Nil(t, err)
+	assert.ElementsMatch(t, []string{"my-key1", "my-key2"}, keys)
+}
+
 func TestGoCacheClear(t *testing.T) {
 	// Given
 	ctrl := gomock.NewController(t)
@@ -296,6 +596,60 @@ func TestGoCacheGetType(t *testing.T) {
 	assert.Equal(t, GoCacheType, store.GetType())
 }
 
+// blockingGoCacheClient is a go-cache client blocking its first Get until
+// release is closed
+type blockingGoCacheClient struct {
+	*cache.Cache
+	once    sync.Once
+	getting chan struct{}
+	release chan struct{}
+}
+
+func (c *blockingGoCacheClient) Get(k string) (any, bool) {
+	c.once.Do(func() {
+		close(c.getting)
+		<-c.release
+	})
+
+	return c.Cache.Get(k)
+}
+
+func TestGoCacheSetDuringSetIfNotExists(t *testing.T) {
+	// Given
+	ctx := context.Background()
+
+	client := &blockingGoCacheClient{
+		Cache:   cache.New(10*time.Second, 30*time.Second),
+		getting: make(chan struct{}),
+		release: make(chan struct{}),
+	}
+	store := NewGoCache(client)
+
+	conditionalErr := make(chan error)
+	go func() {
+		conditionalErr <- store.SetIfNotExists(ctx, "my-key", "my-first-value")
+	}()
+	<-client.getting
+
+	// When
+	setErr := make(chan error)
+	go func() {
+		setErr <- store.Set(ctx, "my-key", "my-second-value")
+	}()
+
+	// Give the set a chance to run while the key is being checked
+	time.Sleep(50 * time.Millisecond)
+	close(client.release)
+
+	// Then
+	assert.Nil(t, <-conditionalErr)
+	assert.Nil(t, <-setErr)
+
+	value, err := store.Get(ctx, "my-key")
+	assert.Nil(t, err)
+	assert.Equal(t, "my-second-value", value)
+}
+
 func TestGoCacheSetTagsConcurrency(t *testing.T) {
 	ctx := context.Background()
 
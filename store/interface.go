@@ -15,3 +15,64 @@ type StoreInterface interface {
 	Clear(ctx context.Context) error
 	GetType() string
 }
+
+// BulkStoreInterface is the interface for stores able to handle several keys
+// at once. Keys that are not found are omitted from the GetMany returned map.
+type BulkStoreInterface interface {
+	GetMany(ctx context.Context, keys []any) (map[any]any, error)
+	SetMany(ctx context.Context, items map[any]any, options ...Option) error
+	DeleteMany(ctx context.Context, keys []any) error
+}
+
+// BulkTTLStoreInterface is the interface for stores able to return several
+// keys along with their remaining TTL at once. Keys that are not found are
+// omitted from both returned maps.
+type BulkTTLStoreInterface interface {
+	GetManyWithTTL(ctx context.Context, keys []any) (map[any]any, map[any]time.Duration, error)
+}
+
+// ConditionalStoreInterface is the interface for stores able to set a value
+// only when its key does not exist yet. An AlreadyExists error is returned
+// otherwise.
+type ConditionalStoreInterface interface {
+	SetIfNotExists(ctx context.Context, key any, value any, options ...Option) error
+}
+
+// VersionedStoreInterface is the interface for stores handling optimistic
+// concurrency. SetIfVersion and DeleteIfVersion only change a value when it
+// has not changed since GetWithVersion returned the given version, and return
+// a VersionConflict error otherwise.
+type VersionedStoreInterface interface {
+	GetWithVersion(ctx context.Context, key any) (any, Version, error)
+	SetIfVersion(ctx context.Context, key any, value any, version Version, options ...Option) error
+	DeleteIfVersion(ctx context.Context, key any, version Version) error
+}
+
+// ExistsStoreInterface is the interface for stores able to check whether a
+// key exists without retrieving its value
+type ExistsStoreInterface interface {
+	Exists(ctx context.Context, key any) (bool, error)
+}
+
+// TouchStoreInterface is the interface for stores able to update the
+// expiration of a key without rewriting its value. A ttl of 0 means no
+// expiration and a NotFound error is returned when the key does not exist.
+type TouchStoreInterface interface {
+	Touch(ctx context.Context, key any, ttl time.Duration) error
+}
+
+// ScanStoreInterface is the interface for stores able to enumerate their keys.
+// Scan calls fn for each key matching the given glob-style pattern (see
+// MatchPattern) and stops at the first error returned by fn, which is then
+// returned. Keys are not sorted and may be given more than once when the store
+// is modified during the scan.
+type ScanStoreInterface interface {
+	Scan(ctx context.Context, pattern string, fn func(key string) error) error
+}
+
+// CounterStoreInterface is the interface for stores able to atomically
+// increment integer values. Missing keys are considered as 0 and the
+// expiration option is only applied when the key is created.
+type CounterStoreInterface interface {
+	Increment(ctx context.Context, key any, delta int64, options ...Option) (int64, error)
+}
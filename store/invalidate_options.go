@@ -4,11 +4,21 @@ package store
 type InvalidateOption func(o *invalidateOptions)
 
 type invalidateOptions struct {
-	tags []string
+	tags         []string
+	pattern      string
+	deletedCount *int64
 }
 
 func (o *invalidateOptions) isEmpty() bool {
-	return len(o.tags) == 0
+	return len(o.tags) == 0 && o.pattern == ""
+}
+
+// addDeleted adds the given number of keys deleted by pattern to the counter
+// given using WithInvalidateDeletedCount, if any
+func (o *invalidateOptions) addDeleted(deleted int64) {
+	if o.deletedCount != nil {
+		*o.deletedCount += deleted
+	}
 }
 
 func applyInvalidateOptionsWithDefault(defaultOptions *invalidateOptions, opts ...InvalidateOption) *invalidateOptions {
@@ -37,3 +47,36 @@ func WithInvalidateTags(tags []string) InvalidateOption {
 		o.tags = tags
 	}
 }
+
+// WithInvalidatePattern allows invalidating all the keys matching the given
+// glob-style pattern (see MatchPattern). Stores unable to enumerate their keys
+// return ErrUnsupportedOperation.
+func WithInvalidatePattern(pattern string) InvalidateOption {
+	return func(o *invalidateOptions) {
+		o.pattern = pattern
+	}
+}
+
+// WithInvalidatePrefix allows invalidating all the keys starting with the given
+// prefix. Stores unable to enumerate their keys return ErrUnsupportedOperation.
+func WithInvalidatePrefix(prefix string) InvalidateOption {
+	return func(o *invalidateOptions) {
+		o.pattern = EscapePattern(prefix) + "*"
+	}
+}
+
+// InvalidateDeletedCount returns the counter given among the given options
+// using WithInvalidateDeletedCount, if any, so that caches invalidating several
+// stores can report the number of keys deleted themselves
+func InvalidateDeletedCount(opts ...InvalidateOption) *int64 {
+	return applyInvalidateOptions(opts...).deletedCount
+}
+
+// WithInvalidateDeletedCount allows retrieving the number of keys deleted by
+// WithInvalidatePattern or WithInvalidatePrefix, which is added to the given
+// counter.
+func WithInvalidateDeletedCount(count *int64) InvalidateOption {
+	return func(o *invalidateOptions) {
+		o.deletedCount = count
+	}
+}
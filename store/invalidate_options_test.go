@@ -15,3 +15,30 @@ func TestInvalidateOptionsTagsValue(t *testing.T) {
 	// When - Then
 	assert.Equal(t, []string{"tag1", "tag2", "tag3"}, options.tags)
 }
+
+func TestInvalidateOptionsPrefixValue(t *testing.T) {
+	// Given
+	var deleted int64
+
+	// When
+	options := applyInvalidateOptions(
+		WithInvalidatePrefix("user:[42]:"),
+		WithInvalidateDeletedCount(&deleted),
+	)
+	options.addDeleted(2)
+	options.addDeleted(3)
+
+	// Then
+	assert.Equal(t, "user:\\[42\\]:*", options.pattern)
+	assert.False(t, options.isEmpty())
+	assert.Equal(t, int64(5), deleted)
+}
+
+func TestInvalidateDeletedCount(t *testing.T) {
+	// Given
+	var deleted int64
+
+	// When - Then
+	assert.Equal(t, &deleted, InvalidateDeletedCount(WithInvalidatePattern("user:*"), WithInvalidateDeletedCount(&deleted)))
+	assert.Nil(t, InvalidateDeletedCount(WithInvalidatePattern("user:*")))
+}
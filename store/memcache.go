@@ -4,7 +4,10 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"io"
+	"strconv"
 	"strings"
+	"sync"
 	"time"
 
 	"golang.org/x/sync/errgroup"
@@ -15,11 +18,15 @@ import (
 // MemcacheClientInterface represents a bradfitz/gomemcache client
 type MemcacheClientInterface interface {
 	Get(key string) (item *memcache.Item, err error)
+	GetMulti(keys []string) (map[string]*memcache.Item, error)
 	Set(item *memcache.Item) error
 	Delete(item string) error
 	FlushAll() error
 	CompareAndSwap(item *memcache.Item) error
 	Add(item *memcache.Item) error
+	Touch(key string, seconds int32) error
+	Increment(key string, delta uint64) (newValue uint64, err error)
+	Decrement(key string, delta uint64) (newValue uint64, err error)
 }
 
 const (
@@ -27,6 +34,11 @@ const (
 	MemcacheType = "memcache"
 	// MemcacheTagPattern represents the tag pattern to be used as a key in specified storage
 	MemcacheTagPattern = "gocache_tag_%s"
+	// MemcacheNamespacePattern represents the key holding the current generation of a namespace
+	MemcacheNamespacePattern = "gocache_namespace_%s"
+	// DefaultMemcacheNamespaceRefresh represents the default interval after
+	// which the generation of a namespace is read again
+	DefaultMemcacheNamespaceRefresh = time.Second
 
 	TagKeyExpiry = 720 * time.Hour
 )
@@ -35,19 +47,44 @@ const (
 type MemcacheStore struct {
 	client  MemcacheClientInterface
 	options *options
+
+	// generationRefresh is the interval after which the generation of the
+	// store namespace is read again
+	generationRefresh time.Duration
+
+	// generationMu guards the generation of the store namespace read last,
+	// which is reused until generationExpiry. generationSequence changes on
+	// every Clear so that a generation read meanwhile is not kept.
+	generationMu       sync.Mutex
+	generationValue    int64
+	generationExpiry   time.Time
+	generationSequence uint64
 }
 
 // NewMemcache creates a new store to Memcache instance(s)
 func NewMemcache(client MemcacheClientInterface, options ...Option) *MemcacheStore {
+	o := applyOptions(options...)
+
+	refresh := o.namespaceRefresh
+	if refresh == 0 {
+		refresh = DefaultMemcacheNamespaceRefresh
+	}
+
 	return &MemcacheStore{
-		client:  client,
-		options: applyOptions(options...),
+		client:            client,
+		options:           o,
+		generationRefresh: refresh,
 	}
 }
 
 // Get returns data stored from a given key
 func (s *MemcacheStore) Get(_ context.Context, key any) (any, error) {
-	item, err := s.client.Get(key.(string))
+	cacheKey, err := s.key(key)
+	if err != nil {
+		return nil, err
+	}
+
+	item, err := s.client.Get(cacheKey)
 	if err != nil {
 		return nil, err
 	}
@@ -60,7 +97,12 @@ func (s *MemcacheStore) Get(_ context.Context, key any) (any, error) {
 
 // GetWithTTL returns data stored from a given key and its corresponding TTL
 func (s *MemcacheStore) GetWithTTL(_ context.Context, key any) (any, time.Duration, error) {
-	item, err := s.client.Get(key.(string))
+	cacheKey, err := s.key(key)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	item, err := s.client.Get(cacheKey)
 	if err != nil {
 		return nil, 0, err
 	}
@@ -75,13 +117,23 @@ func (s *MemcacheStore) GetWithTTL(_ context.Context, key any) (any, time.Durati
 func (s *MemcacheStore) Set(ctx context.Context, key any, value any, options ...Option) error {
 	opts := applyOptionsWithDefault(s.options, options...)
 
+	cacheKey, err := s.key(key)
+	if err != nil {
+		return err
+	}
+
+	val, err := valueBytes(value)
+	if err != nil {
+		return err
+	}
+
 	item := &memcache.Item{
-		Key:        key.(string),
-		Value:      value.([]byte),
+		Key:        cacheKey,
+		Value:      val,
 		Expiration: int32(opts.expiration.Seconds()),
 	}
 
-	err := s.client.Set(item)
+	err = s.client.Set(item)
 	if err != nil {
 		return err
 	}
@@ -94,15 +146,22 @@ func (s *MemcacheStore) Set(ctx context.Context, key any, value any, options ...
 }
 
 func (s *MemcacheStore) setTags(ctx context.Context, key any, tags []string) {
+	member, err := keyString(key)
+	if err != nil {
+		return
+	}
+
 	group, ctx := errgroup.WithContext(ctx)
 	for _, tag := range tags {
 		currentTag := tag
 		group.Go(func() error {
-			tagKey := fmt.Sprintf(MemcacheTagPattern, currentTag)
+			tagKey, err := s.key(fmt.Sprintf(MemcacheTagPattern, currentTag))
+			if err != nil {
+				return err
+			}
 
-			var err error
 			for i := 0; i < 3; i++ {
-				if err = s.addKeyToTagValue(tagKey, key); err == nil {
+				if err = s.addKeyToTagValue(tagKey, member); err == nil {
 					return nil
 				}
 				// loop to retry any failure (including race conditions)
@@ -115,7 +174,7 @@ func (s *MemcacheStore) setTags(ctx context.Context, key any, tags []string) {
 	group.Wait()
 }
 
-func (s *MemcacheStore) addKeyToTagValue(tagKey string, key any) error {
+func (s *MemcacheStore) addKeyToTagValue(tagKey string, key string) error {
 	var (
 		cacheKeys = []string{}
 		result    *memcache.Item
@@ -131,12 +190,12 @@ func (s *MemcacheStore) addKeyToTagValue(tagKey string, key any) error {
 
 	for _, cacheKey := range cacheKeys {
 		// if key already exists, nothing to do
-		if cacheKey == key.(string) {
+		if cacheKey == key {
 			return nil
 		}
 	}
 
-	cacheKeys = append(cacheKeys, key.(string))
+	cacheKeys = append(cacheKeys, key)
 
 	newVal := []byte(strings.Join(cacheKeys, ","))
 
@@ -158,13 +217,318 @@ func (s *MemcacheStore) addKeyToTagValue(tagKey string, key any) error {
 
 // Delete removes data from Memcache for given key identifier
 func (s *MemcacheStore) Delete(_ context.Context, key any) error {
-	return s.client.Delete(key.(string))
+	cacheKey, err := s.key(key)
+	if err != nil {
+		return err
+	}
+
+	err = s.client.Delete(cacheKey)
+	if errors.Is(err, memcache.ErrCacheMiss) {
+		return NotFoundWithCause(err)
+	}
+
+	return err
+}
+
+// GetMany returns data stored from given keys using a single GetMulti call
+func (s *MemcacheStore) GetMany(_ context.Context, keys []any) (map[any]any, error) {
+	items, err := s.getMulti(keys)
+	if err != nil {
+		return nil, err
+	}
+
+	values := make(map[any]any, len(items))
+	for key, item := range items {
+		values[key] = item.Value
+	}
+
+	return values, nil
+}
+
+// GetManyWithTTL returns data stored from given keys along with their TTL
+// using a single GetMulti call
+func (s *MemcacheStore) GetManyWithTTL(_ context.Context, keys []any) (map[any]any, map[any]time.Duration, error) {
+	items, err := s.getMulti(keys)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	values := make(map[any]any, len(items))
+	ttls := make(map[any]time.Duration, len(items))
+	for key, item := range items {
+		values[key] = item.Value
+		ttls[key] = time.Duration(item.Expiration) * time.Second
+	}
+
+	return values, ttls, nil
+}
+
+// getMulti returns the items stored for the given keys using a single
+// GetMulti call. Keys that are not found are omitted.
+func (s *MemcacheStore) getMulti(keys []any) (map[any]*memcache.Item, error) {
+	prefix, err := s.keyPrefix()
+	if err != nil {
+		return nil, err
+	}
+
+	cacheKeys := make([]string, len(keys))
+	for i, key := range keys {
+		k, err := keyString(key)
+		if err != nil {
+			return nil, err
+		}
+		cacheKeys[i] = prefix + k
+	}
+
+	items, err := s.client.GetMulti(cacheKeys)
+	if err != nil {
+		return nil, err
+	}
+
+	found := make(map[any]*memcache.Item, len(items))
+	for i, key := range keys {
+		if item, ok := items[cacheKeys[i]]; ok && item != nil {
+			found[key] = item
+		}
+	}
+
+	return found, nil
+}
+
+// SetMany defines data in Memcache for given key identifiers
+func (s *MemcacheStore) SetMany(ctx context.Context, items map[any]any, options ...Option) error {
+	for key, value := range items {
+		if err := s.Set(ctx, key, value, options...); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// DeleteMany removes data from Memcache for given key identifiers
+func (s *MemcacheStore) DeleteMany(_ context.Context, keys []any) error {
+	prefix, err := s.keyPrefix()
+	if err != nil {
+		return err
+	}
+
+	for _, key := range keys {
+		k, err := keyString(key)
+		if err != nil {
+			return err
+		}
+
+		err = s.client.Delete(prefix + k)
+		if err != nil && !errors.Is(err, memcache.ErrCacheMiss) {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// SetIfNotExists defines data in Memcache for given key identifier only when
+// the key does not exist yet, using the add command
+func (s *MemcacheStore) SetIfNotExists(ctx context.Context, key any, value any, options ...Option) error {
+	opts := applyOptionsWithDefault(s.options, options...)
+
+	cacheKey, err := s.key(key)
+	if err != nil {
+		return err
+	}
+
+	val, err := valueBytes(value)
+	if err != nil {
+		return err
+	}
+
+	item := &memcache.Item{
+		Key:        cacheKey,
+		Value:      val,
+		Expiration: int32(opts.expiration.Seconds()),
+	}
+
+	err = s.client.Add(item)
+	if errors.Is(err, memcache.ErrNotStored) {
+		return AlreadyExistsWithCause(err)
+	}
+	if err != nil {
+		return err
+	}
+
+	if tags := opts.tags; len(tags) > 0 {
+		s.setTags(ctx, key, tags)
+	}
+
+	return nil
+}
+
+// GetWithVersion returns data stored from a given key and its version, which
+// relies on the Memcache CAS identifier
+func (s *MemcacheStore) GetWithVersion(_ context.Context, key any) (any, Version, error) {
+	cacheKey, err := s.key(key)
+	if err != nil {
+		return nil, Version{}, err
+	}
+
+	item, err := s.client.Get(cacheKey)
+	if errors.Is(err, memcache.ErrCacheMiss) {
+		return nil, Version{}, NotFoundWithCause(err)
+	}
+	if err != nil {
+		return nil, Version{}, err
+	}
+	if item == nil {
+		return nil, Version{}, NotFoundWithCause(errors.New("unable to retrieve data from memcache"))
+	}
+
+	return item.Value, Version{token: item}, nil
+}
+
+// SetIfVersion defines data in Memcache for given key identifier only when its
+// value has not changed since the given version, using the cas command
+func (s *MemcacheStore) SetIfVersion(ctx context.Context, key any, value any, version Version, options ...Option) error {
+	versionItem, err := s.versionItem(key, version)
+	if err != nil {
+		return err
+	}
+
+	val, err := valueBytes(value)
+	if err != nil {
+		return err
+	}
+
+	opts := applyOptionsWithDefault(s.options, options...)
+
+	// Copy the item returned by GetWithVersion to keep its CAS identifier
+	item := *versionItem
+	item.Value = val
+	item.Expiration = int32(opts.expiration.Seconds())
+
+	err = s.client.CompareAndSwap(&item)
+	if errors.Is(err, memcache.ErrCASConflict) || errors.Is(err, memcache.ErrNotStored) {
+		return VersionConflictWithCause(err)
+	}
+	if err != nil {
+		return err
+	}
+
+	if tags := opts.tags; len(tags) > 0 {
+		s.setTags(ctx, key, tags)
+	}
+
+	return nil
+}
+
+// DeleteIfVersion removes data from Memcache for given key identifier only when
+// its value has not changed since the given version. As Memcache has no
+// conditional delete, the value is replaced using the cas command by an
+// already expired one.
+func (s *MemcacheStore) DeleteIfVersion(_ context.Context, key any, version Version) error {
+	versionItem, err := s.versionItem(key, version)
+	if err != nil {
+		return err
+	}
+
+	item := *versionItem
+	item.Expiration = -1
+
+	err = s.client.CompareAndSwap(&item)
+	if errors.Is(err, memcache.ErrCASConflict) || errors.Is(err, memcache.ErrNotStored) {
+		return VersionConflictWithCause(err)
+	}
+
+	return err
+}
+
+// Exists returns whether the given key exists in Memcache. As Memcache has no
+// existence check command, the value is retrieved.
+func (s *MemcacheStore) Exists(_ context.Context, key any) (bool, error) {
+	cacheKey, err := s.key(key)
+	if err != nil {
+		return false, err
+	}
+
+	_, err = s.client.Get(cacheKey)
+	if errors.Is(err, memcache.ErrCacheMiss) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+// Touch updates the expiration of the given key in Memcache using the touch command
+func (s *MemcacheStore) Touch(_ context.Context, key any, ttl time.Duration) error {
+	cacheKey, err := s.key(key)
+	if err != nil {
+		return err
+	}
+
+	err = s.client.Touch(cacheKey, int32(ttl.Seconds()))
+	if errors.Is(err, memcache.ErrCacheMiss) {
+		return NotFoundWithCause(err)
+	}
+
+	return err
+}
+
+// Increment atomically adds delta to the integer stored at the given key using
+// the incr and decr commands. Memcache counters are unsigned so decrementing
+// below 0 sets the counter to 0.
+func (s *MemcacheStore) Increment(_ context.Context, key any, delta int64, options ...Option) (int64, error) {
+	opts := applyOptionsWithDefault(s.options, options...)
+
+	cacheKey, err := s.key(key)
+	if err != nil {
+		return 0, err
+	}
+
+	for i := 0; i < 3; i++ {
+		var counter uint64
+		if delta < 0 {
+			counter, err = s.client.Decrement(cacheKey, uint64(-delta))
+		} else {
+			counter, err = s.client.Increment(cacheKey, uint64(delta))
+		}
+		if !errors.Is(err, memcache.ErrCacheMiss) {
+			return int64(counter), err
+		}
+
+		initial := delta
+		if initial < 0 {
+			initial = 0
+		}
+
+		err = s.client.Add(&memcache.Item{
+			Key:        cacheKey,
+			Value:      FormatCounter(initial),
+			Expiration: int32(opts.expiration.Seconds()),
+		})
+		if err == nil {
+			return initial, nil
+		}
+		if !errors.Is(err, memcache.ErrNotStored) {
+			return 0, err
+		}
+		// loop to increment the counter created concurrently
+	}
+
+	return 0, err
 }
 
 // Invalidate invalidates some cache data in Memcache for given options
 func (s *MemcacheStore) Invalidate(ctx context.Context, options ...InvalidateOption) error {
 	opts := applyInvalidateOptions(options...)
 
+	// Keys cannot be enumerated to be matched against a pattern
+	if opts.pattern != "" {
+		return ErrUnsupportedOperation
+	}
+
 	if tags := opts.tags; len(tags) > 0 {
 		for _, tag := range tags {
 			tagKey := fmt.Sprintf(MemcacheTagPattern, tag)
@@ -187,9 +551,183 @@ func (s *MemcacheStore) Invalidate(ctx context.Context, options ...InvalidateOpt
 	return nil
 }
 
-// Clear resets all data in the store
+// Clear resets all data of the store namespace by incrementing its generation,
+// which makes the keys of the previous generation unreachable until they are
+// evicted. Other stores using the namespace see the new generation once their
+// WithNamespaceRefresh interval has elapsed. Stores without namespace flush the
+// whole Memcache server when the WithFlushAll option is given and return
+// ErrNamespaceRequired otherwise.
 func (s *MemcacheStore) Clear(_ context.Context) error {
-	return s.client.FlushAll()
+	if s.options.namespace == "" {
+		if !s.options.flushAll {
+			return ErrNamespaceRequired
+		}
+
+		return s.client.FlushAll()
+	}
+
+	generation, err := s.client.Increment(fmt.Sprintf(MemcacheNamespacePattern, s.options.namespace), 1)
+	if errors.Is(err, memcache.ErrCacheMiss) {
+		// A new generation is created on next access
+		s.resetGeneration()
+		return nil
+	}
+	if err != nil {
+		s.resetGeneration()
+		return err
+	}
+
+	s.keepGeneration(s.resetGeneration(), int64(generation))
+
+	return nil
+}
+
+// key returns the Memcache key of the given key identifier in the store namespace
+func (s *MemcacheStore) key(key any) (string, error) {
+	k, err := keyString(key)
+	if err != nil {
+		return "", err
+	}
+
+	prefix, err := s.keyPrefix()
+	if err != nil {
+		return "", err
+	}
+
+	return prefix + k, nil
+}
+
+// keyPrefix returns the prefix of the keys of the store namespace, which
+// includes its current generation
+func (s *MemcacheStore) keyPrefix() (string, error) {
+	if s.options.namespace == "" {
+		return "", nil
+	}
+
+	generation, err := s.generation()
+	if err != nil {
+		return "", err
+	}
+
+	return s.options.namespacedKey(strconv.FormatInt(generation, 10) + NamespaceSeparator), nil
+}
+
+// generation returns the current generation of the store namespace, read again
+// once the WithNamespaceRefresh interval has elapsed
+func (s *MemcacheStore) generation() (int64, error) {
+	s.generationMu.Lock()
+	if time.Now().Before(s.generationExpiry) {
+		generation := s.generationValue
+		s.generationMu.Unlock()
+		return generation, nil
+	}
+	sequence := s.generationSequence
+	s.generationMu.Unlock()
+
+	generation, err := s.readGeneration()
+	if err != nil {
+		return 0, err
+	}
+
+	s.keepGeneration(sequence, generation)
+
+	return generation, nil
+}
+
+// keepGeneration keeps the given generation until the WithNamespaceRefresh
+// interval has elapsed, unless the namespace has been cleared since the given
+// sequence was taken
+func (s *MemcacheStore) keepGeneration(sequence uint64, generation int64) {
+	if s.generationRefresh <= 0 {
+		return
+	}
+
+	s.generationMu.Lock()
+	defer s.generationMu.Unlock()
+
+	if sequence != s.generationSequence {
+		return
+	}
+
+	s.generationValue = generation
+	s.generationExpiry = time.Now().Add(s.generationRefresh)
+}
+
+// resetGeneration forgets the generation kept by the store, and returns the
+// new sequence
+func (s *MemcacheStore) resetGeneration() uint64 {
+	s.generationMu.Lock()
+	defer s.generationMu.Unlock()
+
+	s.generationSequence++
+	s.generationExpiry = time.Time{}
+
+	return s.generationSequence
+}
+
+// readGeneration reads the current generation of the store namespace. A
+// missing generation is initialized with the current time so that the keys of
+// a previous generation do not become reachable again when it has been
+// evicted.
+func (s *MemcacheStore) readGeneration() (int64, error) {
+	generationKey := fmt.Sprintf(MemcacheNamespacePattern, s.options.namespace)
+
+	item, err := s.client.Get(generationKey)
+	if errors.Is(err, memcache.ErrCacheMiss) {
+		generation := time.Now().UnixNano()
+
+		err = s.client.Add(&memcache.Item{
+			Key:   generationKey,
+			Value: FormatCounter(generation),
+		})
+		if !errors.Is(err, memcache.ErrNotStored) {
+			return generation, err
+		}
+
+		// The generation has been created concurrently
+		item, err = s.client.Get(generationKey)
+	}
+	if err != nil {
+		return 0, err
+	}
+
+	return parseCounter(string(item.Value))
+}
+
+// versionItem returns the item held by the given version after checking it
+// has been issued for the given key. Versions issued before the namespace was
+// cleared are considered as conflicting.
+func (s *MemcacheStore) versionItem(key any, version Version) (*memcache.Item, error) {
+	versionItem, ok := version.token.(*memcache.Item)
+	if !ok {
+		return nil, ErrInvalidVersion
+	}
+
+	cacheKey, err := s.key(key)
+	if err != nil {
+		return nil, err
+	}
+
+	if versionItem.Key != cacheKey {
+		k, _ := keyString(key)
+		prefix := s.options.namespacePrefix()
+		if prefix != "" && strings.HasPrefix(versionItem.Key, prefix) && strings.HasSuffix(versionItem.Key, NamespaceSeparator+k) {
+			return nil, VersionConflictWithCause(errors.New("namespace has been cleared"))
+		}
+
+		return nil, ErrInvalidVersion
+	}
+
+	return versionItem, nil
+}
+
+// Close closes the Memcache client, when it can be closed
+func (s *MemcacheStore) Close() error {
+	if closer, ok := s.client.(io.Closer); ok {
+		return closer.Close()
+	}
+
+	return nil
 }
 
 // GetType returns the store type
@@ -296,6 +296,25 @@ func TestMemcacheDelete(t *testing.T) {
 	assert.Nil(t, err)
 }
 
+func TestMemcacheDeleteWhenNotFound(t *testing.T) {
+	// Given
+	ctrl := gomock.NewController(t)
+
+	ctx := context.Background()
+
+	client := mocksStore.NewMockMemcacheClientInterface(ctrl)
+	client.EXPECT().Delete("my-key").Return(memcache.ErrCacheMiss)
+
+	store := NewMemcache(client)
+
+	// When
+	err := store.Delete(ctx, "my-key")
+
+	// Then
+	assert.ErrorIs(t, err, &NotFound{})
+	assert.ErrorIs(t, err, memcache.ErrCacheMiss)
+}
+
 func TestMemcacheDeleteWhenError(t *testing.T) {
 	// Given
 	ctrl := gomock.NewController(t)
@@ -318,6 +337,323 @@ func TestMemcacheDeleteWhenError(t *testing.T) {
 	assert.Equal(t, expectedErr, err)
 }
 
+func TestMemcacheGetMany(t *testing.T) {
+	// Given
+	ctrl := gomock.NewController(t)
+
+	ctx := context.Background()
+
+	client := mocksStore.NewMockMemcacheClientInterface(ctrl)
+	client.EXPECT().GetMulti([]string{"key-1", "key-2"}).Return(map[string]*memcache.Item{
+		"key-1": {Key: "key-1", Value: []byte("value 1")},
+	}, nil)
+
+	store := NewMemcache(client)
+
+	// When
+	values, err := store.GetMany(ctx, []any{"key-1", "key-2"})
+
+	// Then
+	assert.Nil(t, err)
+	assert.Equal(t, map[any]any{"key-1": []byte("value 1")}, values)
+}
+
+func TestMemcacheGetManyWithTTL(t *testing.T) {
+	// Given
+	ctrl := gomock.NewController(t)
+
+	ctx := context.Background()
+
+	client := mocksStore.NewMockMemcacheClientInterface(ctrl)
+	client.EXPECT().GetMulti([]string{"key-1", "key-2"}).Return(map[string]*memcache.Item{
+		"key-1": {Key: "key-1", Value: []byte("value 1"), Expiration: 5},
+	}, nil)
+
+	store := NewMemcache(client)
+
+	// When
+	values, ttls, err := store.GetManyWithTTL(ctx, []any{"key-1", "key-2"})
+
+	// Then
+	assert.Nil(t, err)
+	assert.Equal(t, map[any]any{"key-1": []byte("value 1")}, values)
+	assert.Equal(t, map[any]time.Duration{"key-1": 5 * time.Second}, ttls)
+}
+
+func TestMemcacheDeleteMany(t *testing.T) {
+	// Given
+	ctrl := gomock.NewController(t)
+
+	ctx := context.Background()
+
+	client := mocksStore.NewMockMemcacheClientInterface(ctrl)
+	client.EXPECT().Delete("key-1").Return(nil)
+	client.EXPECT().Delete("key-2").Return(memcache.ErrCacheMiss)
+
+	store := NewMemcache(client)
+
+	// When
+	err := store.DeleteMany(ctx, []any{"key-1", "key-2"})
+
+	// Then
+	assert.Nil(t, err)
+}
+
+func TestMemcacheSetIfNotExists(t *testing.T) {
+	// Given
+	ctrl := gomock.NewController(t)
+
+	ctx := context.Background()
+
+	client := mocksStore.NewMockMemcacheClientInterface(ctrl)
+	client.EXPECT().Add(&memcache.Item{
+		Key:        "my-key",
+		Value:      []byte("my-value"),
+		Expiration: int32(5),
+	}).Return(nil)
+
+	store := NewMemcache(client, WithExpiration(5*time.Second))
+
+	// When
+	err := store.SetIfNotExists(ctx, "my-key", []byte("my-value"))
+
+	// Then
+	assert.Nil(t, err)
+}
+
+func TestMemcacheSetIfNotExistsWhenAlreadyExists(t *testing.T) {
+	// Given
+	ctrl := gomock.NewController(t)
+
+	ctx := context.Background()
+
+	client := mocksStore.NewMockMemcacheClientInterface(ctrl)
+	client.EXPECT().Add(gomock.Any()).Return(memcache.ErrNotStored)
+
+	store := NewMemcache(client)
+
+	// When
+	err := store.SetIfNotExists(ctx, "my-key", []byte("my-value"))
+
+	// Then
+	assert.ErrorIs(t, err, &AlreadyExists{})
+	assert.ErrorIs(t, err, memcache.ErrNotStored)
+}
+
+func TestMemcacheSetIfVersion(t *testing.T) {
+	// Given
+	ctrl := gomock.NewController(t)
+
+	ctx := context.Background()
+
+	item := &memcache.Item{
+		Key:   "my-key",
+		Value: []byte("value 1"),
+	}
+
+	client := mocksStore.NewMockMemcacheClientInterface(ctrl)
+	client.EXPECT().Get("my-key").Return(item, nil)
+	client.EXPECT().CompareAndSwap(&memcache.Item{
+		Key:        "my-key",
+		Value:      []byte("value 2"),
+		Expiration: int32(5),
+	}).Return(nil)
+
+	store := NewMemcache(client, WithExpiration(5*time.Second))
+
+	value, version, err := store.GetWithVersion(ctx, "my-key")
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("value 1"), value)
+
+	// When
+	err = store.SetIfVersion(ctx, "my-key", []byte("value 2"), version)
+
+	// Then
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("value 1"), item.Value)
+}
+
+func TestMemcacheSetIfVersionWhenConflict(t *testing.T) {
+	// Given
+	ctrl := gomock.NewController(t)
+
+	ctx := context.Background()
+
+	client := mocksStore.NewMockMemcacheClientInterface(ctrl)
+	client.EXPECT().CompareAndSwap(gomock.Any()).Return(memcache.ErrCASConflict)
+
+	store := NewMemcache(client)
+
+	// When
+	err := store.SetIfVersion(ctx, "my-key", []byte("value 2"), Version{token: &memcache.Item{Key: "my-key"}})
+
+	// Then
+	assert.ErrorIs(t, err, &VersionConflict{})
+	assert.ErrorIs(t, err, memcache.ErrCASConflict)
+}
+
+func TestMemcacheDeleteIfVersion(t *testing.T) {
+	// Given
+	ctrl := gomock.NewController(t)
+
+	ctx := context.Background()
+
+	client := mocksStore.NewMockMemcacheClientInterface(ctrl)
+	client.EXPECT().CompareAndSwap(&memcache.Item{
+		Key:        "my-key",
+		Value:      []byte("value 1"),
+		Expiration: int32(-1),
+	}).Return(nil)
+
+	store := NewMemcache(client)
+
+	// When
+	err := store.DeleteIfVersion(ctx, "my-key", Version{token: &memcache.Item{Key: "my-key", Value: []byte("value 1")}})
+
+	// Then
+	assert.Nil(t, err)
+}
+
+func TestMemcacheGetWithVersionWhenNotFound(t *testing.T) {
+	// Given
+	ctrl := gomock.NewController(t)
+
+	ctx := context.Background()
+
+	client := mocksStore.NewMockMemcacheClientInterface(ctrl)
+	client.EXPECT().Get("my-key").Return(nil, memcache.ErrCacheMiss)
+
+	store := NewMemcache(client)
+
+	// When
+	value, _, err := store.GetWithVersion(ctx, "my-key")
+
+	// Then
+	assert.Nil(t, value)
+	assert.ErrorIs(t, err, &NotFound{})
+}
+
+func TestMemcacheExistsWhenMissing(t *testing.T) {
+	// Given
+	ctrl := gomock.NewController(t)
+
+	ctx := context.Background()
+
+	client := mocksStore.NewMockMemcacheClientInterface(ctrl)
+	client.EXPECT().Get("my-key").Return(nil, memcache.ErrCacheMiss)
+
+	store := NewMemcache(client)
+
+	// When
+	exists, err := store.Exists(ctx, "my-key")
+
+	// Then
+	assert.Nil(t, err)
+	assert.False(t, exists)
+}
+
+func TestMemcacheTouch(t *testing.T) {
+	// Given
+	ctrl := gomock.NewController(t)
+
+	ctx := context.Background()
+
+	client := mocksStore.NewMockMemcacheClientInterface(ctrl)
+	client.EXPECT().Touch("my-key", int32(10)).Return(nil)
+
+	store := NewMemcache(client)
+
+	// When
+	err := store.Touch(ctx, "my-key", 10*time.Second)
+
+	// Then
+	assert.Nil(t, err)
+}
+
+func TestMemcacheTouchWhenNotFound(t *testing.T) {
+	// Given
+	ctrl := gomock.NewController(t)
+
+	ctx := context.Background()
+
+	client := mocksStore.NewMockMemcacheClientInterface(ctrl)
+	client.EXPECT().Touch("my-key", int32(10)).Return(memcache.ErrCacheMiss)
+
+	store := NewMemcache(client)
+
+	// When
+	err := store.Touch(ctx, "my-key", 10*time.Second)
+
+	// Then
+	assert.ErrorIs(t, err, &NotFound{})
+}
+
+func TestMemcacheIncrement(t *testing.T) {
+	// Given
+	ctrl := gomock.NewController(t)
+
+	ctx := context.Background()
+
+	client := mocksStore.NewMockMemcacheClientInterface(ctrl)
+	client.EXPECT().Decrement("my-counter", uint64(2)).Return(uint64(3), nil)
+
+	store := NewMemcache(client)
+
+	// When
+	counter, err := store.Increment(ctx, "my-counter", -2)
+
+	// Then
+	assert.Nil(t, err)
+	assert.Equal(t, int64(3), counter)
+}
+
+func TestMemcacheIncrementWhenMissing(t *testing.T) {
+	// Given
+	ctrl := gomock.NewController(t)
+
+	ctx := context.Background()
+
+	client := mocksStore.NewMockMemcacheClientInterface(ctrl)
+	client.EXPECT().Increment("my-counter", uint64(4)).Return(uint64(0), memcache.ErrCacheMiss)
+	client.EXPECT().Add(&memcache.Item{
+		Key:        "my-counter",
+		Value:      []byte("4"),
+		Expiration: int32(5),
+	}).Return(nil)
+
+	store := NewMemcache(client, WithExpiration(5*time.Second))
+
+	// When
+	counter, err := store.Increment(ctx, "my-counter", 4)
+
+	// Then
+	assert.Nil(t, err)
+	assert.Equal(t, int64(4), counter)
+}
+
+func TestMemcacheIncrementWhenCreatedConcurrently(t *testing.T) {
+	// Given
+	ctrl := gomock.NewController(t)
+
+	ctx := context.Background()
+
+	client := mocksStore.NewMockMemcacheClientInterface(ctrl)
+	gomock.InOrder(
+		client.EXPECT().Increment("my-counter", uint64(1)).Return(uint64(0), memcache.ErrCacheMiss),
+		client.EXPECT().Add(gomock.Any()).Return(memcache.ErrNotStored),
+		client.EXPECT().Increment("my-counter", uint64(1)).Return(uint64(2), nil),
+	)
+
+	store := NewMemcache(client)
+
+	// When
+	counter, err := store.Increment(ctx, "my-counter", 1)
+
+	// Then
+	assert.Nil(t, err)
+	assert.Equal(t, int64(2), counter)
+}
+
 func TestMemcacheInvalidate(t *testing.T) {
 	// Given
 	ctrl := gomock.NewController(t)
@@ -366,6 +702,23 @@ func TestMemcacheInvalidateWhenError(t *testing.T) {
 	assert.Nil(t, err)
 }
 
+func TestMemcacheInvalidatePatternIsUnsupported(t *testing.T) {
+	// Given
+	ctrl := gomock.NewController(t)
+
+	ctx := context.Background()
+
+	client := mocksStore.NewMockMemcacheClientInterface(ctrl)
+
+	store := NewMemcache(client)
+
+	// When
+	err := store.Invalidate(ctx, WithInvalidatePattern("user:42:*"))
+
+	// Then
+	assert.Equal(t, ErrUnsupportedOperation, err)
+}
+
 func TestMemcacheClear(t *testing.T) {
 	// Given
 	ctrl := gomock.NewController(t)
@@ -375,7 +728,7 @@ func TestMemcacheClear(t *testing.T) {
 	client := mocksStore.NewMockMemcacheClientInterface(ctrl)
 	client.EXPECT().FlushAll().Return(nil)
 
-	store := NewMemcache(client)
+	store := NewMemcache(client, WithFlushAll())
 
 	// When
 	err := store.Clear(ctx)
@@ -395,7 +748,7 @@ func TestMemcacheClearWhenError(t *testing.T) {
 	client := mocksStore.NewMockMemcacheClientInterface(ctrl)
 	client.EXPECT().FlushAll().Return(expectedErr)
 
-	store := NewMemcache(client)
+	store := NewMemcache(client, WithFlushAll())
 
 	// When
 	err := store.Clear(ctx)
@@ -415,3 +768,180 @@ func TestMemcacheGetType(t *testing.T) {
 	// When - Then
 	assert.Equal(t, MemcacheType, store.GetType())
 }
+
+func TestMemcacheClearWithoutNamespace(t *testing.T) {
+	// Given
+	ctrl := gomock.NewController(t)
+
+	ctx := context.Background()
+
+	client := mocksStore.NewMockMemcacheClientInterface(ctrl)
+
+	store := NewMemcache(client)
+
+	// When
+	err := store.Clear(ctx)
+
+	// Then
+	assert.Equal(t, ErrNamespaceRequired, err)
+}
+
+func TestMemcacheClearWithNamespace(t *testing.T) {
+	// Given
+	ctrl := gomock.NewController(t)
+
+	ctx := context.Background()
+
+	client := mocksStore.NewMockMemcacheClientInterface(ctrl)
+	client.EXPECT().Increment("gocache_namespace_my-app", uint64(1)).Return(uint64(43), nil)
+
+	store := NewMemcache(client, WithNamespace("my-app"))
+
+	// When
+	err := store.Clear(ctx)
+
+	// Then
+	assert.Nil(t, err)
+}
+
+func TestMemcacheGetWithNamespace(t *testing.T) {
+	// Given
+	ctrl := gomock.NewController(t)
+
+	ctx := context.Background()
+
+	client := mocksStore.NewMockMemcacheClientInterface(ctrl)
+	client.EXPECT().Get("gocache_namespace_my-app").Return(&memcache.Item{Value: []byte("42")}, nil)
+	client.EXPECT().Get("my-app:42:my-key").Return(&memcache.Item{Value: []byte("my-value")}, nil)
+
+	store := NewMemcache(client, WithNamespace("my-app"))
+
+	// When
+	value, err := store.Get(ctx, "my-key")
+
+	// Then
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("my-value"), value)
+}
+
+func TestMemcacheGetWithNamespaceKeepsGeneration(t *testing.T) {
+	// Given
+	ctrl := gomock.NewController(t)
+
+	ctx := context.Background()
+
+	client := mocksStore.NewMockMemcacheClientInterface(ctrl)
+	client.EXPECT().Get("gocache_namespace_my-app").Return(&memcache.Item{Value: []byte("42")}, nil)
+	client.EXPECT().Get("my-app:42:my-key").Return(&memcache.Item{Value: []byte("my-value")}, nil).Times(2)
+
+	store := NewMemcache(client, WithNamespace("my-app"), WithNamespaceRefresh(time.Minute))
+
+	// When
+	_, err1 := store.Get(ctx, "my-key")
+	_, err2 := store.Get(ctx, "my-key")
+
+	// Then
+	assert.Nil(t, err1)
+	assert.Nil(t, err2)
+}
+
+func TestMemcacheGetWithNamespaceWhenRefreshIsDisabled(t *testing.T) {
+	// Given
+	ctrl := gomock.NewController(t)
+
+	ctx := context.Background()
+
+	client := mocksStore.NewMockMemcacheClientInterface(ctrl)
+	gomock.InOrder(
+		client.EXPECT().Get("gocache_namespace_my-app").Return(&memcache.Item{Value: []byte("42")}, nil),
+		client.EXPECT().Get("my-app:42:my-key").Return(&memcache.Item{Value: []byte("my-value")}, nil),
+		client.EXPECT().Get("gocache_namespace_my-app").Return(&memcache.Item{Value: []byte("43")}, nil),
+		client.EXPECT().Get("my-app:43:my-key").Return(nil, memcache.ErrCacheMiss),
+	)
+
+	store := NewMemcache(client, WithNamespace("my-app"), WithNamespaceRefresh(-1))
+
+	// When
+	_, err1 := store.Get(ctx, "my-key")
+	_, err2 := store.Get(ctx, "my-key")
+
+	// Then
+	assert.Nil(t, err1)
+	assert.Equal(t, memcache.ErrCacheMiss, err2)
+}
+
+func TestMemcacheGetWithNamespaceAfterClear(t *testing.T) {
+	// Given
+	ctrl := gomock.NewController(t)
+
+	ctx := context.Background()
+
+	client := mocksStore.NewMockMemcacheClientInterface(ctrl)
+	gomock.InOrder(
+		client.EXPECT().Get("gocache_namespace_my-app").Return(&memcache.Item{Value: []byte("42")}, nil),
+		client.EXPECT().Get("my-app:42:my-key").Return(&memcache.Item{Value: []byte("my-value")}, nil),
+		client.EXPECT().Increment("gocache_namespace_my-app", uint64(1)).Return(uint64(43), nil),
+		client.EXPECT().Get("my-app:43:my-key").Return(nil, memcache.ErrCacheMiss),
+	)
+
+	store := NewMemcache(client, WithNamespace("my-app"), WithNamespaceRefresh(time.Minute))
+
+	// When
+	_, err1 := store.Get(ctx, "my-key")
+	errClear := store.Clear(ctx)
+	_, err2 := store.Get(ctx, "my-key")
+
+	// Then
+	assert.Nil(t, err1)
+	assert.Nil(t, errClear)
+	assert.Equal(t, memcache.ErrCacheMiss, err2)
+}
+
+func TestMemcacheGetWithNamespaceWhenGenerationIsMissing(t *testing.T) {
+	// Given
+	ctrl := gomock.NewController(t)
+
+	ctx := context.Background()
+
+	var generation []byte
+
+	client := mocksStore.NewMockMemcacheClientInterface(ctrl)
+	client.EXPECT().Get("gocache_namespace_my-app").Return(nil, memcache.ErrCacheMiss)
+	client.EXPECT().Add(gomock.Any()).DoAndReturn(func(item *memcache.Item) error {
+		assert.Equal(t, "gocache_namespace_my-app", item.Key)
+		generation = item.Value
+		return nil
+	})
+	client.EXPECT().Get(gomock.Any()).DoAndReturn(func(key string) (*memcache.Item, error) {
+		assert.Equal(t, "my-app:"+string(generation)+":my-key", key)
+		return nil, memcache.ErrCacheMiss
+	})
+
+	store := NewMemcache(client, WithNamespace("my-app"))
+
+	// When
+	_, err := store.Get(ctx, "my-key")
+
+	// Then
+	assert.Equal(t, memcache.ErrCacheMiss, err)
+}
+
+func TestMemcacheSetIfVersionWhenNamespaceHasBeenCleared(t *testing.T) {
+	// Given
+	ctrl := gomock.NewController(t)
+
+	ctx := context.Background()
+
+	client := mocksStore.NewMockMemcacheClientInterface(ctrl)
+	client.EXPECT().Get("gocache_namespace_my-app").Return(&memcache.Item{Value: []byte("43")}, nil)
+
+	store := NewMemcache(client, WithNamespace("my-app"))
+
+	version := Version{token: &memcache.Item{Key: "my-app:42:my-key", Value: []byte("my-value")}}
+
+	// When
+	err := store.SetIfVersion(ctx, "my-key", []byte("my-new-value"), version)
+
+	// Then
+	assert.ErrorIs(t, err, &VersionConflict{})
+}
@@ -0,0 +1,47 @@
+package store
+
+import (
+	"strings"
+)
+
+// NamespaceSeparator represents the separator between the namespace of a store
+// and its keys
+const NamespaceSeparator = ":"
+
+// namespacePrefix returns the prefix of the keys stored in the namespace, or
+// an empty string when no namespace is given
+func (o *options) namespacePrefix() string {
+	if o.namespace == "" {
+		return ""
+	}
+
+	return o.namespace + NamespaceSeparator
+}
+
+// namespacedKey returns the given key prefixed with the namespace
+func (o *options) namespacedKey(key string) string {
+	return o.namespacePrefix() + key
+}
+
+// namespacedPattern returns the given pattern restricted to the keys of the
+// namespace
+func (o *options) namespacedPattern(pattern string) string {
+	if pattern == "" {
+		pattern = "*"
+	}
+
+	return EscapePattern(o.namespacePrefix()) + pattern
+}
+
+// unnamespacedScan wraps the given scan function so that it receives keys
+// without the namespace prefix
+func (o *options) unnamespacedScan(fn func(key string) error) func(key string) error {
+	prefix := o.namespacePrefix()
+	if prefix == "" {
+		return fn
+	}
+
+	return func(key string) error {
+		return fn(strings.TrimPrefix(key, prefix))
+	}
+}
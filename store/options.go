@@ -11,6 +11,11 @@ type options struct {
 	cost       int64
 	expiration time.Duration
 	tags       []string
+	namespace  string
+	flushAll   bool
+	overrides  []Option
+
+	namespaceRefresh time.Duration
 }
 
 func (o *options) isEmpty() bool {
@@ -18,16 +23,31 @@ func (o *options) isEmpty() bool {
 }
 
 func applyOptionsWithDefault(defaultOptions *options, opts ...Option) *options {
-	returnedOptions := applyOptions(opts...)
+	returnedOptions := collectOptions(opts...)
 
 	if returnedOptions.isEmpty() {
-		returnedOptions = defaultOptions
+		// Copy the default options so that overrides do not change them
+		defaults := *defaultOptions
+		if len(defaultOptions.tags) > 0 {
+			defaults.tags = append([]string{}, defaultOptions.tags...)
+		}
+		defaults.overrides = returnedOptions.overrides
+		returnedOptions = &defaults
 	}
 
+	returnedOptions.applyOverrides()
+
 	return returnedOptions
 }
 
 func applyOptions(opts ...Option) *options {
+	o := collectOptions(opts...)
+	o.applyOverrides()
+
+	return o
+}
+
+func collectOptions(opts ...Option) *options {
 	o := &options{}
 
 	for _, opt := range opts {
@@ -37,6 +57,17 @@ func applyOptions(opts ...Option) *options {
 	return o
 }
 
+func (o *options) applyOverrides() {
+	for len(o.overrides) > 0 {
+		overrides := o.overrides
+		o.overrides = nil
+
+		for _, opt := range overrides {
+			opt(o)
+		}
+	}
+}
+
 // WithCost allows setting the memory capacity used by the item when setting a value.
 // Actually it seems to be used by Ristretto library only.
 func WithCost(cost int64) Option {
@@ -58,3 +89,63 @@ func WithTags(tags []string) Option {
 		o.tags = tags
 	}
 }
+
+// WithMaxExpiration allows to cap the expiration time given by the previous
+// options. Values without expiration are given the max expiration. Use it with
+// WithOverride to also cap the store default expiration.
+func WithMaxExpiration(max time.Duration) Option {
+	return func(o *options) {
+		if o.expiration <= 0 || o.expiration > max {
+			o.expiration = max
+		}
+	}
+}
+
+// WithTagsFunc allows to rewrite the tags given by the previous options.
+func WithTagsFunc(fn func(tags []string) []string) Option {
+	return func(o *options) {
+		o.tags = fn(o.tags)
+	}
+}
+
+// WithOverride allows to apply the given options once the options of a value
+// have been resolved, including the store default options when no other
+// option is given. Options given only as overrides do not replace the store
+// default options.
+func WithOverride(opts ...Option) Option {
+	return func(o *options) {
+		o.overrides = append(o.overrides, opts...)
+	}
+}
+
+// WithNamespace allows to prefix all the keys of a shared store (Redis, Redis
+// Cluster or Memcache) with the given namespace, so that Clear only removes the
+// keys of this namespace. It is a store option, ignored when setting a value.
+func WithNamespace(namespace string) Option {
+	return func(o *options) {
+		o.namespace = namespace
+	}
+}
+
+// WithNamespaceRefresh sets the interval after which a Memcache store reads
+// again the generation of its namespace, which is shared with the other
+// processes using the namespace and changed when any of them clears it. The
+// store keeps using the previous generation until then, so a Clear issued by
+// another process is only seen after this interval, while a Clear issued by
+// the store is seen right away. It defaults to
+// DefaultMemcacheNamespaceRefresh, and a negative interval reads the
+// generation on every operation, at the cost of an additional round trip.
+func WithNamespaceRefresh(interval time.Duration) Option {
+	return func(o *options) {
+		o.namespaceRefresh = interval
+	}
+}
+
+// WithFlushAll allows Clear to flush the whole server of a shared store (Redis,
+// Redis Cluster or Memcache) which has no namespace, including the keys of other
+// applications. Clear returns ErrNamespaceRequired otherwise.
+func WithFlushAll() Option {
+	return func(o *options) {
+		o.flushAll = true
+	}
+}
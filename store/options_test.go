@@ -36,3 +36,113 @@ func TestOptionsTagsValue(t *testing.T) {
 	// When - Then
 	assert.Equal(t, []string{"tag1", "tag2", "tag3"}, options.tags)
 }
+
+func TestOptionsNamespaceValue(t *testing.T) {
+	// Given
+	options := applyOptions(WithNamespace("my-app"), WithFlushAll())
+
+	// When - Then
+	assert.Equal(t, "my-app", options.namespace)
+	assert.True(t, options.flushAll)
+	assert.Equal(t, "my-app:my-key", options.namespacedKey("my-key"))
+	assert.Equal(t, "my-app:user:*", options.namespacedPattern("user:*"))
+}
+
+func TestOptionsMaxExpiration(t *testing.T) {
+	testCases := map[string]struct {
+		expiration time.Duration
+		expected   time.Duration
+	}{
+		"without expiration": {expiration: 0, expected: 30 * time.Second},
+		"below max":          {expiration: 10 * time.Second, expected: 10 * time.Second},
+		"above max":          {expiration: 5 * time.Minute, expected: 30 * time.Second},
+		"equal to max":       {expiration: 30 * time.Second, expected: 30 * time.Second},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// When
+			options := applyOptions(
+				WithExpiration(testCase.expiration),
+				WithMaxExpiration(30*time.Second),
+			)
+
+			// Then
+			assert.Equal(t, testCase.expected, options.expiration)
+		})
+	}
+}
+
+func TestOptionsTagsFunc(t *testing.T) {
+	// When
+	options := applyOptions(
+		WithTags([]string{"tag1", "tag2"}),
+		WithTagsFunc(func(tags []string) []string {
+			return append(tags, "l1")
+		}),
+	)
+
+	// Then
+	assert.Equal(t, []string{"tag1", "tag2", "l1"}, options.tags)
+}
+
+func TestOptionsWithOverride(t *testing.T) {
+	defaultOptions := applyOptions(
+		WithExpiration(10*time.Second),
+		WithTags([]string{"tag1"}),
+	)
+
+	testCases := map[string]struct {
+		options    []Option
+		cost       int64
+		expiration time.Duration
+		tags       []string
+	}{
+		"max expiration above the default one": {
+			options:    []Option{WithOverride(WithMaxExpiration(30 * time.Second))},
+			expiration: 10 * time.Second,
+			tags:       []string{"tag1"},
+		},
+		"max expiration below the default one": {
+			options:    []Option{WithOverride(WithMaxExpiration(5 * time.Second))},
+			expiration: 5 * time.Second,
+			tags:       []string{"tag1"},
+		},
+		"cost only": {
+			options:    []Option{WithOverride(WithCost(8))},
+			cost:       8,
+			expiration: 10 * time.Second,
+			tags:       []string{"tag1"},
+		},
+		"tags rewriting": {
+			options: []Option{WithOverride(WithTagsFunc(func(tags []string) []string {
+				return append(tags, "l1")
+			}))},
+			expiration: 10 * time.Second,
+			tags:       []string{"tag1", "l1"},
+		},
+		"given options": {
+			options: []Option{
+				WithExpiration(time.Minute),
+				WithOverride(WithMaxExpiration(30 * time.Second)),
+			},
+			expiration: 30 * time.Second,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// When
+			options := applyOptionsWithDefault(defaultOptions, testCase.options...)
+
+			// Then
+			assert.Equal(t, testCase.cost, options.cost)
+			assert.Equal(t, testCase.expiration, options.expiration)
+			assert.Equal(t, testCase.tags, options.tags)
+		})
+	}
+
+	// Default options are not changed by overrides
+	assert.Equal(t, 10*time.Second, defaultOptions.expiration)
+	assert.Equal(t, []string{"tag1"}, defaultOptions.tags)
+}
@@ -16,10 +16,7 @@ type OptionsMatcher struct {
 func (m OptionsMatcher) Matches(x interface{}) bool {
 	switch values := x.(type) {
 	case []Option:
-		opts := &options{}
-		for _, value := range values {
-			value(opts)
-		}
+		opts := applyOptions(values...)
 
 		return opts.cost == m.Cost &&
 			opts.expiration == m.Expiration &&
@@ -0,0 +1,152 @@
+package store
+
+import (
+	"context"
+	"strings"
+)
+
+// MatchPattern returns whether the given key matches a glob-style pattern using
+// the Redis syntax: * matches any sequence of characters, ? matches a single
+// character, [abc], [^abc] and [a-z] match a set of characters and \ escapes
+// the next character. An empty pattern matches any key.
+func MatchPattern(pattern string, key string) bool {
+	if pattern == "" {
+		return true
+	}
+
+	return matchPattern(pattern, key)
+}
+
+// EscapePattern escapes the special characters of the given string so that it
+// can be used as a literal in a glob-style pattern
+func EscapePattern(s string) string {
+	var builder strings.Builder
+	for i := 0; i < len(s); i++ {
+		switch s[i] {
+		case '*', '?', '[', ']', '\\':
+			builder.WriteByte('\\')
+		}
+		builder.WriteByte(s[i])
+	}
+
+	return builder.String()
+}
+
+func matchPattern(pattern string, key string) bool {
+	for len(pattern) > 0 {
+		switch pattern[0] {
+		case '*':
+			for len(pattern) > 1 && pattern[1] == '*' {
+				pattern = pattern[1:]
+			}
+			if len(pattern) == 1 {
+				return true
+			}
+			for i := 0; i <= len(key); i++ {
+				if matchPattern(pattern[1:], key[i:]) {
+					return true
+				}
+			}
+			return false
+
+		case '?':
+			if len(key) == 0 {
+				return false
+			}
+
+		case '[':
+			if len(key) == 0 {
+				return false
+			}
+			matched, rest := matchClass(pattern[1:], key[0])
+			if !matched {
+				return false
+			}
+			pattern, key = rest, key[1:]
+			continue
+
+		case '\\':
+			if len(pattern) > 1 {
+				pattern = pattern[1:]
+			}
+			fallthrough
+
+		default:
+			if len(key) == 0 || pattern[0] != key[0] {
+				return false
+			}
+		}
+
+		pattern, key = pattern[1:], key[1:]
+	}
+
+	return len(key) == 0
+}
+
+// matchClass returns whether the given character belongs to the class starting
+// at the beginning of the pattern and the remaining pattern after the class
+func matchClass(pattern string, c byte) (bool, string) {
+	negate := len(pattern) > 0 && pattern[0] == '^'
+	if negate {
+		pattern = pattern[1:]
+	}
+
+	matched := false
+	for len(pattern) > 0 && pattern[0] != ']' {
+		switch {
+		case pattern[0] == '\\' && len(pattern) > 1:
+			matched = matched || pattern[1] == c
+			pattern = pattern[2:]
+
+		case len(pattern) > 2 && pattern[1] == '-' && pattern[2] != ']':
+			low, high := pattern[0], pattern[2]
+			if low > high {
+				low, high = high, low
+			}
+			matched = matched || (c >= low && c <= high)
+			pattern = pattern[3:]
+
+		default:
+			matched = matched || pattern[0] == c
+			pattern = pattern[1:]
+		}
+	}
+
+	if len(pattern) > 0 {
+		pattern = pattern[1:]
+	}
+
+	return matched != negate, pattern
+}
+
+// deleteMatching scans the keys matching the given pattern and deletes them in
+// batches of batchSize keys using the given function, which returns the number
+// of keys it deleted. A batchSize of 0 deletes all the keys once the scan is
+// over, for stores which cannot be modified while being iterated. It returns the
+// number of deleted keys.
+func deleteMatching(ctx context.Context, scanStore ScanStoreInterface, pattern string, batchSize int, deleteFn func(ctx context.Context, keys []string) (int64, error)) (int64, error) {
+	var deleted int64
+	var batch []string
+
+	flush := func() error {
+		count, err := deleteFn(ctx, batch)
+		deleted += count
+		batch = batch[:0]
+
+		return err
+	}
+
+	err := scanStore.Scan(ctx, pattern, func(key string) error {
+		batch = append(batch, key)
+		if batchSize > 0 && len(batch) >= batchSize {
+			return flush()
+		}
+
+		return nil
+	})
+	if err == nil && len(batch) > 0 {
+		err = flush()
+	}
+
+	return deleted, err
+}
@@ -0,0 +1,52 @@
+package store
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMatchPattern(t *testing.T) {
+	testCases := []struct {
+		pattern string
+		key     string
+		match   bool
+	}{
+		{"", "my-key", true},
+		{"*", "my-key", true},
+		{"*", "", true},
+		{"my-key", "my-key", true},
+		{"my-key", "my-key2", false},
+		{"my-*", "my-key", true},
+		{"my-*", "other-key", false},
+		{"*-key", "my-key", true},
+		{"m*y*k**y", "my-key", true},
+		{"my-?ey", "my-key", true},
+		{"my-?ey", "my-ey", false},
+		{"my-[kb]ey", "my-key", true},
+		{"my-[^kb]ey", "my-key", false},
+		{"my-[a-m]ey", "my-key", true},
+		{"my-[m-a]ey", "my-key", true},
+		{"my-[n-z]ey", "my-key", false},
+		{"my\\*key", "my*key", true},
+		{"my\\*key", "my-key", false},
+		{"my-[\\]]key", "my-]key", true},
+	}
+
+	for _, tc := range testCases {
+		assert.Equal(t, tc.match, MatchPattern(tc.pattern, tc.key), "pattern %q, key %q", tc.pattern, tc.key)
+	}
+}
+
+func TestEscapePattern(t *testing.T) {
+	// Given
+	key := "my-*key?[1]\\"
+
+	// When
+	pattern := EscapePattern(key)
+
+	// Then
+	assert.Equal(t, "my-\\*key\\?\\[1\\]\\\\", pattern)
+	assert.True(t, MatchPattern(pattern, key))
+	assert.False(t, MatchPattern(pattern, "my-otherkey?[1]\\"))
+}
@@ -1,6 +1,7 @@
 package store
 
 import (
+	"bytes"
 	"context"
 	"errors"
 	"fmt"
@@ -21,11 +22,23 @@ const (
 	PegasusNOTTL   = -1
 	PegasusNOENTRY = -2
 
+	// PegasusPendingVersionTTL is the time after which the version of a value
+	// whose conditional write has been interrupted can be read again
+	PegasusPendingVersionTTL = 10 * time.Second
+
 	DefaultTable             = "gocache_pegasus"
 	DefaultTablePartitionNum = 4
 	DefaultScanNum           = 100
 )
 
+// pegasusVersion is the sort key of the version of the value stored under a
+// hash key, which is replaced or dropped every time the value is written
+var pegasusVersion = []byte("version")
+
+// pegasusPendingVersion prefixes the versions of the values being written by
+// a conditional write or an increment
+var pegasusPendingVersion = []byte("pending:")
+
 // empty represent empty sort key, more info reference: https://github.com/XiaoMi/pegasus-go-client/blob/f3b6b08bc4c227982bb5b73106329435fda97a38/pegasus/table_connector.go#L83
 var empty = []byte("-")
 
@@ -127,13 +140,18 @@ func (p *PegasusStore) Close() error {
 
 // Get returns data stored from a given key
 func (p *PegasusStore) Get(ctx context.Context, key any) (any, error) {
+	hashKey, err := pegasusHashKey(key)
+	if err != nil {
+		return nil, err
+	}
+
 	table, err := p.client.OpenTable(ctx, p.options.TableName)
 	if err != nil {
 		return nil, err
 	}
 	defer table.Close()
 
-	value, err := table.Get(ctx, []byte(cast.ToString(key)), empty)
+	value, err := table.Get(ctx, hashKey, empty)
 	if err != nil {
 		return nil, err
 	}
@@ -145,13 +163,18 @@ func (p *PegasusStore) Get(ctx context.Context, key any) (any, error) {
 
 // GetWithTTL returns data stored from a given key and its corresponding TTL
 func (p *PegasusStore) GetWithTTL(ctx context.Context, key any) (any, time.Duration, error) {
+	hashKey, err := pegasusHashKey(key)
+	if err != nil {
+		return nil, 0, err
+	}
+
 	table, err := p.client.OpenTable(ctx, p.options.TableName)
 	if err != nil {
 		return nil, 0, err
 	}
 	defer table.Close()
 
-	value, err := table.Get(ctx, []byte(cast.ToString(key)), empty)
+	value, err := table.Get(ctx, hashKey, empty)
 	if err != nil {
 		return nil, 0, err
 	}
@@ -159,7 +182,7 @@ func (p *PegasusStore) GetWithTTL(ctx context.Context, key any) (any, time.Durat
 		return nil, 0, &NotFound{}
 	}
 
-	ttl, err := table.TTL(ctx, []byte(cast.ToString(key)), empty)
+	ttl, err := table.TTL(ctx, hashKey, empty)
 	if err != nil {
 		return nil, 0, err
 	}
@@ -167,8 +190,19 @@ func (p *PegasusStore) GetWithTTL(ctx context.Context, key any) (any, time.Durat
 	return value, time.Duration(ttl) * time.Second, nil
 }
 
-// Set defines data in Pegasus for given key identifier
+// Set defines data in Pegasus for given key identifier along with a new
+// version, in a single operation
 func (p *PegasusStore) Set(ctx context.Context, key, value any, options ...Option) error {
+	hashKey, err := pegasusHashKey(key)
+	if err != nil {
+		return err
+	}
+
+	val, err := pegasusValue(value)
+	if err != nil {
+		return err
+	}
+
 	opts := applyOptions(options...)
 
 	table, err := p.client.OpenTable(ctx, p.options.TableName)
@@ -177,20 +211,20 @@ func (p *PegasusStore) Set(ctx context.Context, key, value any, options ...Optio
 	}
 	defer table.Close()
 
-	err = table.SetTTL(ctx, []byte(cast.ToString(key)), empty, []byte(cast.ToString(value)), opts.expiration)
+	err = p.setWithVersion(ctx, table, hashKey, val, opts.expiration)
 	if err != nil {
 		return err
 	}
 
 	if tags := opts.tags; len(tags) > 0 {
-		if err = p.setTags(ctx, key, tags); err != nil {
+		if err = p.setTags(ctx, string(hashKey), tags); err != nil {
 			return err
 		}
 	}
 	return nil
 }
 
-func (p *PegasusStore) setTags(ctx context.Context, key any, tags []string) error {
+func (p *PegasusStore) setTags(ctx context.Context, key string, tags []string) error {
 	for _, tag := range tags {
 		tagKey := fmt.Sprintf(PegasusTagPattern, tag)
 		cacheKeys := []string{}
@@ -203,14 +237,14 @@ func (p *PegasusStore) setTags(ctx context.Context, key any, tags []string) erro
 
 		alreadyInserted := false
 		for _, cacheKey := range cacheKeys {
-			if cacheKey == key.(string) {
+			if cacheKey == key {
 				alreadyInserted = true
 				break
 			}
 		}
 
 		if !alreadyInserted {
-			cacheKeys = append(cacheKeys, key.(string))
+			cacheKeys = append(cacheKeys, key)
 		}
 
 		if err := p.Set(ctx, tagKey, []byte(strings.Join(cacheKeys, ",")), WithExpiration(720*time.Hour)); err != nil {
@@ -221,20 +255,424 @@ func (p *PegasusStore) setTags(ctx context.Context, key any, tags []string) erro
 	return nil
 }
 
-// Delete removes data from Pegasus for given key identifier
+// Delete removes data from Pegasus for given key identifier along with its
+// version, in a single operation
 func (p *PegasusStore) Delete(ctx context.Context, key any) error {
+	hashKey, err := pegasusHashKey(key)
+	if err != nil {
+		return err
+	}
+
+	table, err := p.client.OpenTable(ctx, p.options.TableName)
+	if err != nil {
+		return err
+	}
+	defer table.Close()
+
+	return table.MultiDel(ctx, hashKey, [][]byte{empty, pegasusVersion})
+}
+
+// GetMany returns data stored from given keys using a single BatchGet call
+func (p *PegasusStore) GetMany(ctx context.Context, keys []any) (map[any]any, error) {
+	values := make(map[any]any, len(keys))
+	if len(keys) == 0 {
+		return values, nil
+	}
+
+	compositeKeys := make([]pegasus.CompositeKey, len(keys))
+	for i, key := range keys {
+		hashKey, err := pegasusHashKey(key)
+		if err != nil {
+			return nil, err
+		}
+
+		compositeKeys[i] = pegasus.CompositeKey{
+			HashKey: hashKey,
+			SortKey: empty,
+		}
+	}
+
+	table, err := p.client.OpenTable(ctx, p.options.TableName)
+	if err != nil {
+		return nil, err
+	}
+	defer table.Close()
+
+	objects, err := table.BatchGet(ctx, compositeKeys)
+	if err != nil {
+		return nil, err
+	}
+
+	for i, object := range objects {
+		if object != nil {
+			values[keys[i]] = object
+		}
+	}
+
+	return values, nil
+}
+
+// SetMany defines data in Pegasus for given key identifiers
+func (p *PegasusStore) SetMany(ctx context.Context, items map[any]any, options ...Option) error {
+	for key, value := range items {
+		if err := p.Set(ctx, key, value, options...); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// DeleteMany removes data from Pegasus for given key identifiers
+func (p *PegasusStore) DeleteMany(ctx context.Context, keys []any) error {
+	hashKeys := make([][]byte, len(keys))
+	for i, key := range keys {
+		hashKey, err := pegasusHashKey(key)
+		if err != nil {
+			return err
+		}
+		hashKeys[i] = hashKey
+	}
+
+	table, err := p.client.OpenTable(ctx, p.options.TableName)
+	if err != nil {
+		return err
+	}
+	defer table.Close()
+
+	for _, hashKey := range hashKeys {
+		if err := table.MultiDel(ctx, hashKey, [][]byte{empty, pegasusVersion}); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// SetIfNotExists defines data in Pegasus for given key identifier only when
+// the key does not exist yet, using a check and set operation
+func (p *PegasusStore) SetIfNotExists(ctx context.Context, key, value any, options ...Option) error {
+	hashKey, err := pegasusHashKey(key)
+	if err != nil {
+		return err
+	}
+
+	val, err := pegasusValue(value)
+	if err != nil {
+		return err
+	}
+
+	opts := applyOptions(options...)
+
+	table, err := p.client.OpenTable(ctx, p.options.TableName)
+	if err != nil {
+		return err
+	}
+	defer table.Close()
+
+	result, err := table.CheckAndSet(ctx, hashKey, empty, pegasus.CheckTypeValueNotExist, nil, empty, val, &pegasus.CheckAndSetOptions{
+		SetValueTTLSeconds: int(opts.expiration.Seconds()),
+	})
+	if err != nil {
+		return err
+	}
+	if !result.SetSucceed {
+		return AlreadyExistsWithCause(errors.New("value already exists in Pegasus store"))
+	}
+
+	// Drop the version left by a previous value which expired meanwhile
+	if err := table.Del(ctx, hashKey, pegasusVersion); err != nil {
+		return err
+	}
+
+	if tags := opts.tags; len(tags) > 0 {
+		if err = p.setTags(ctx, string(hashKey), tags); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// GetWithVersion returns data stored from a given key and its version, which
+// is kept under the version sort key of the same hash key until the value is
+// written. The version is created using a check and set operation when the
+// value has none yet, before reading the value.
+func (p *PegasusStore) GetWithVersion(ctx context.Context, key any) (any, Version, error) {
+	hashKey, err := pegasusHashKey(key)
+	if err != nil {
+		return nil, Version{}, err
+	}
+
+	table, err := p.client.OpenTable(ctx, p.options.TableName)
+	if err != nil {
+		return nil, Version{}, err
+	}
+	defer table.Close()
+
+	version, err := table.Get(ctx, hashKey, pegasusVersion)
+	if err != nil {
+		return nil, Version{}, err
+	}
+	if version == nil {
+		if version, err = p.createVersion(ctx, table, hashKey); err != nil {
+			return nil, Version{}, err
+		}
+	}
+
+	value, err := table.Get(ctx, hashKey, empty)
+	if err != nil {
+		return nil, Version{}, err
+	}
+	if value == nil {
+		return nil, Version{}, &NotFound{}
+	}
+
+	// A value being written by a conditional write or an increment is given a
+	// version matching no stored one
+	if bytes.HasPrefix(version, pegasusPendingVersion) {
+		token, err := newVersionToken()
+		if err != nil {
+			return nil, Version{}, err
+		}
+		version = []byte(token)
+	}
+
+	return value, Version{token: version}, nil
+}
+
+// createVersion gives a new version, expiring along with the value, to the
+// value stored under the given hash key, and returns the version of the value
+func (p *PegasusStore) createVersion(ctx context.Context, table pegasus.TableConnector, hashKey []byte) ([]byte, error) {
+	ttl, err := table.TTL(ctx, hashKey, empty)
+	if err != nil {
+		return nil, err
+	}
+	if ttl == PegasusNOENTRY {
+		return nil, &NotFound{}
+	}
+
+	if ttl == PegasusNOTTL {
+		ttl = 0
+	}
+
+	token, err := newVersionToken()
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := table.CheckAndSet(ctx, hashKey, pegasusVersion, pegasus.CheckTypeValueNotExist, nil, pegasusVersion, []byte(token), &pegasus.CheckAndSetOptions{
+		SetValueTTLSeconds: ttl,
+		ReturnCheckValue:   true,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if !result.SetSucceed {
+		return result.CheckValue, nil
+	}
+
+	return []byte(token), nil
+}
+
+// claimVersion replaces the given version of the value stored under the given
+// hash key by a pending one using a check and set operation, so that no other
+// conditional write of the value succeeds until it has been written
+func (p *PegasusStore) claimVersion(ctx context.Context, table pegasus.TableConnector, hashKey []byte, version []byte) error {
+	pending, err := newPendingVersion()
+	if err != nil {
+		return err
+	}
+
+	result, err := table.CheckAndSet(ctx, hashKey, pegasusVersion, pegasus.CheckTypeBytesEqual, version, pegasusVersion, pending, &pegasus.CheckAndSetOptions{
+		SetValueTTLSeconds: int(PegasusPendingVersionTTL.Seconds()),
+	})
+	if err != nil {
+		return err
+	}
+	if !result.SetSucceed {
+		return VersionConflictWithCause(errors.New("value has changed in Pegasus store"))
+	}
+
+	return nil
+}
+
+// SetIfVersion defines data in Pegasus for given key identifier only when its
+// value has not been written since the given version. As Pegasus has no
+// conditional write of several sort keys, the version is first replaced by a
+// pending one using a check and set operation, then the value is written
+// along with a new version. The current expiration is kept when none is given.
+func (p *PegasusStore) SetIfVersion(ctx context.Context, key, value any, version Version, options ...Option) error {
+	current, ok := version.token.([]byte)
+	if !ok {
+		return ErrInvalidVersion
+	}
+
+	hashKey, err := pegasusHashKey(key)
+	if err != nil {
+		return err
+	}
+
+	val, err := pegasusValue(value)
+	if err != nil {
+		return err
+	}
+
+	opts := applyOptions(options...)
+
+	table, err := p.client.OpenTable(ctx, p.options.TableName)
+	if err != nil {
+		return err
+	}
+	defer table.Close()
+
+	if err := p.claimVersion(ctx, table, hashKey, current); err != nil {
+		return err
+	}
+
+	expiration := opts.expiration
+	if expiration == 0 {
+		ttl, err := table.TTL(ctx, hashKey, empty)
+		if err != nil {
+			return err
+		}
+		if ttl > 0 {
+			expiration = time.Duration(ttl) * time.Second
+		}
+	}
+
+	if err := p.setWithVersion(ctx, table, hashKey, val, expiration); err != nil {
+		return err
+	}
+
+	if tags := opts.tags; len(tags) > 0 {
+		if err = p.setTags(ctx, string(hashKey), tags); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// DeleteIfVersion removes data from Pegasus for given key identifier only when
+// its value has not been written since the given version. The version is first
+// replaced by a pending one using a check and set operation, then the value is
+// removed along with its version.
+func (p *PegasusStore) DeleteIfVersion(ctx context.Context, key any, version Version) error {
+	current, ok := version.token.([]byte)
+	if !ok {
+		return ErrInvalidVersion
+	}
+
+	hashKey, err := pegasusHashKey(key)
+	if err != nil {
+		return err
+	}
+
 	table, err := p.client.OpenTable(ctx, p.options.TableName)
 	if err != nil {
 		return err
 	}
 	defer table.Close()
 
-	return table.Del(ctx, []byte(cast.ToString(key)), empty)
+	if err := p.claimVersion(ctx, table, hashKey, current); err != nil {
+		return err
+	}
+
+	return table.MultiDel(ctx, hashKey, [][]byte{empty, pegasusVersion})
+}
+
+// Exists returns whether the given key exists in Pegasus
+func (p *PegasusStore) Exists(ctx context.Context, key any) (bool, error) {
+	hashKey, err := pegasusHashKey(key)
+	if err != nil {
+		return false, err
+	}
+
+	table, err := p.client.OpenTable(ctx, p.options.TableName)
+	if err != nil {
+		return false, err
+	}
+	defer table.Close()
+
+	return table.Exist(ctx, hashKey, empty)
+}
+
+// Increment atomically adds delta to the integer stored at the given key using
+// the Pegasus incr operation. When an expiration is given, the key is first
+// created with it using a check and set operation. The version of the value is
+// made pending while the value is incremented and dropped afterwards.
+func (p *PegasusStore) Increment(ctx context.Context, key any, delta int64, options ...Option) (int64, error) {
+	hashKey, err := pegasusHashKey(key)
+	if err != nil {
+		return 0, err
+	}
+
+	opts := applyOptions(options...)
+
+	table, err := p.client.OpenTable(ctx, p.options.TableName)
+	if err != nil {
+		return 0, err
+	}
+	defer table.Close()
+
+	pending, err := newPendingVersion()
+	if err != nil {
+		return 0, err
+	}
+	if err := table.SetTTL(ctx, hashKey, pegasusVersion, pending, PegasusPendingVersionTTL); err != nil {
+		return 0, err
+	}
+
+	counter, err := p.increment(ctx, table, hashKey, delta, opts.expiration)
+	if err != nil {
+		return 0, err
+	}
+
+	if err := table.Del(ctx, hashKey, pegasusVersion); err != nil {
+		return 0, err
+	}
+
+	return counter, nil
+}
+
+// increment adds delta to the integer stored under the given hash key, first
+// creating it with the given expiration if any
+func (p *PegasusStore) increment(ctx context.Context, table pegasus.TableConnector, hashKey []byte, delta int64, expiration time.Duration) (int64, error) {
+	if expiration > 0 {
+		result, err := table.CheckAndSet(ctx, hashKey, empty, pegasus.CheckTypeValueNotExist, nil, empty, FormatCounter(delta), &pegasus.CheckAndSetOptions{
+			SetValueTTLSeconds: int(expiration.Seconds()),
+		})
+		if err != nil {
+			return 0, err
+		}
+		if result.SetSucceed {
+			return delta, nil
+		}
+	}
+
+	return table.Incr(ctx, hashKey, empty, delta)
 }
 
 // Invalidate invalidates some cache data in Pegasus for given options
 func (p *PegasusStore) Invalidate(ctx context.Context, options ...InvalidateOption) error {
 	opts := applyInvalidateOptions(options...)
+
+	if pattern := opts.pattern; pattern != "" {
+		deleted, err := deleteMatching(ctx, p, pattern, p.options.TableScanNum, func(ctx context.Context, keys []string) (int64, error) {
+			var deleted int64
+			for _, key := range keys {
+				if err := p.Delete(ctx, key); err != nil {
+					return deleted, err
+				}
+				deleted++
+			}
+			return deleted, nil
+		})
+		opts.addDeleted(deleted)
+		if err != nil {
+			return err
+		}
+	}
+
 	if tags := opts.tags; len(tags) > 0 {
 		for _, tag := range tags {
 			tagKey := fmt.Sprintf(PegasusTagPattern, tag)
@@ -259,6 +697,65 @@ func (p *PegasusStore) Invalidate(ctx context.Context, options ...InvalidateOpti
 	return nil
 }
 
+// Scan calls fn for each hash key matching the given pattern using unordered
+// scanners over all the partitions of the table
+func (p *PegasusStore) Scan(ctx context.Context, pattern string, fn func(key string) error) error {
+	table, err := p.client.OpenTable(ctx, p.options.TableName)
+	if err != nil {
+		return err
+	}
+	defer table.Close()
+
+	scanners, err := table.GetUnorderedScanners(ctx, p.options.TablePartitionNum, &pegasus.ScannerOptions{
+		BatchSize: p.options.TableScanNum,
+		NoValue:   true,
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, scanner := range scanners {
+		err = p.scan(ctx, scanner, pattern, fn)
+		scanner.Close()
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// scan iterates sequentially over the hash keys of the given scanner
+func (p *PegasusStore) scan(ctx context.Context, scanner pegasus.Scanner, pattern string, fn func(key string) error) error {
+	for {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		completed, hashKey, sortKey, _, err := scanner.Next(ctx)
+		if err != nil {
+			return err
+		}
+		if completed {
+			return nil
+		}
+
+		// Versions are stored under the same hash keys as the values
+		if !bytes.Equal(sortKey, empty) {
+			continue
+		}
+
+		key := string(hashKey)
+		if !MatchPattern(pattern, key) {
+			continue
+		}
+
+		if err := fn(key); err != nil {
+			return err
+		}
+	}
+}
+
 // Clear resets all data in the store
 func (p *PegasusStore) Clear(ctx context.Context) error {
 	table, err := p.client.OpenTable(ctx, p.options.TableName)
@@ -297,6 +794,60 @@ func (p *PegasusStore) Clear(ctx context.Context) error {
 	return nil
 }
 
+// setWithVersion writes the given value under the given hash key along with
+// a new version, in a single operation
+func (p *PegasusStore) setWithVersion(ctx context.Context, table pegasus.TableConnector, hashKey []byte, value []byte, expiration time.Duration) error {
+	token, err := newVersionToken()
+	if err != nil {
+		return err
+	}
+
+	return table.MultiSetOpt(ctx, hashKey, [][]byte{empty, pegasusVersion}, [][]byte{value, []byte(token)}, expiration)
+}
+
+// newPendingVersion returns a random pending version
+func newPendingVersion() ([]byte, error) {
+	token, err := newVersionToken()
+	if err != nil {
+		return nil, err
+	}
+
+	return append(append([]byte{}, pegasusPendingVersion...), token...), nil
+}
+
+// pegasusHashKey returns the hash key of the given key, converting numbers,
+// byte slices and fmt.Stringer implementations to strings
+func pegasusHashKey(key any) ([]byte, error) {
+	if key == nil {
+		return nil, fmt.Errorf("%w: %T", ErrUnsupportedKeyType, key)
+	}
+
+	hashKey, err := cast.ToStringE(key)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %T", ErrUnsupportedKeyType, key)
+	}
+
+	return []byte(hashKey), nil
+}
+
+// pegasusValue returns the given value as bytes, converting numbers and
+// strings as Pegasus only stores bytes
+func pegasusValue(value any) ([]byte, error) {
+	switch v := value.(type) {
+	case nil:
+		return nil, fmt.Errorf("%w: %T", ErrUnsupportedValueType, value)
+	case []byte:
+		return v, nil
+	}
+
+	val, err := cast.ToStringE(value)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %T", ErrUnsupportedValueType, value)
+	}
+
+	return []byte(val), nil
+}
+
 // GetType returns the store type
 func (p *PegasusStore) GetType() string {
 	return PegasusType
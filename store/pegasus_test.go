@@ -184,6 +184,48 @@ func TestPegasusStore_Delete(t *testing.T) {
 	})
 }
 
+func TestPegasusStore_SetIfVersion(t *testing.T) {
+	Convey("Pegasus TestSetIfVersion for pegasus store", t, func() {
+		skipPegasusTest(t)
+
+		ctx := context.Background()
+
+		p, _ := NewPegasus(ctx, testPegasusOptions())
+		defer p.Close()
+
+		Convey("test set with the current version keeping the ttl", func() {
+			k, retention := "test-gocache-version-key-01", time.Minute*10
+			p.Set(ctx, k, "test-gocache-value", WithExpiration(retention))
+
+			_, version, err := p.GetWithVersion(ctx, k)
+			So(err, ShouldBeNil)
+
+			err = p.SetIfVersion(ctx, k, "test-gocache-value-2", version)
+			So(err, ShouldBeNil)
+
+			value, ttl, _ := p.GetWithTTL(ctx, k)
+			So(cast.ToString(value), ShouldEqual, "test-gocache-value-2")
+			So(ttl, should.BeGreaterThan, 0)
+
+			err = p.SetIfVersion(ctx, k, "test-gocache-value-3", version)
+			So(err, ShouldHaveSameTypeAs, &VersionConflict{})
+		})
+		Convey("test set after the value was written back", func() {
+			k, v := "test-gocache-version-key-02", "test-gocache-value"
+			p.Set(ctx, k, v)
+
+			_, version, err := p.GetWithVersion(ctx, k)
+			So(err, ShouldBeNil)
+
+			p.Set(ctx, k, "test-gocache-value-2")
+			p.Set(ctx, k, v)
+
+			err = p.SetIfVersion(ctx, k, "test-gocache-value-3", version)
+			So(err, ShouldHaveSameTypeAs, &VersionConflict{})
+		})
+	})
+}
+
 func TestPegasusStore_Invalidate(t *testing.T) {
 	Convey("Pegasus TestInvalidate for pegasus store", t, func() {
 		skipPegasusTest(t)
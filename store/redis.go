@@ -2,7 +2,11 @@ package store
 
 import (
 	"context"
+	"encoding"
+	"errors"
 	"fmt"
+	"io"
+	"strings"
 	"time"
 
 	"github.com/go-redis/redis/v8"
@@ -11,13 +15,29 @@ import (
 // RedisClientInterface represents a go-redis/redis client
 type RedisClientInterface interface {
 	Get(ctx context.Context, key string) *redis.StringCmd
+	MGet(ctx context.Context, keys ...string) *redis.SliceCmd
 	TTL(ctx context.Context, key string) *redis.DurationCmd
+	Exists(ctx context.Context, keys ...string) *redis.IntCmd
+	Persist(ctx context.Context, key string) *redis.BoolCmd
 	Expire(ctx context.Context, key string, expiration time.Duration) *redis.BoolCmd
 	Set(ctx context.Context, key string, values any, expiration time.Duration) *redis.StatusCmd
+	SetNX(ctx context.Context, key string, value any, expiration time.Duration) *redis.BoolCmd
+	IncrBy(ctx context.Context, key string, value int64) *redis.IntCmd
 	Del(ctx context.Context, keys ...string) *redis.IntCmd
+	Unlink(ctx context.Context, keys ...string) *redis.IntCmd
 	FlushAll(ctx context.Context) *redis.StatusCmd
 	SAdd(ctx context.Context, key string, members ...any) *redis.IntCmd
 	SMembers(ctx context.Context, key string) *redis.StringSliceCmd
+	Eval(ctx context.Context, script string, keys []string, args ...any) *redis.Cmd
+	Pipelined(ctx context.Context, fn func(redis.Pipeliner) error) ([]redis.Cmder, error)
+	TxPipelined(ctx context.Context, fn func(redis.Pipeliner) error) ([]redis.Cmder, error)
+	Scan(ctx context.Context, cursor uint64, match string, count int64) *redis.ScanCmd
+}
+
+// redisScanner represents a go-redis/redis client able to scan keys, which is
+// either a single instance or a cluster master
+type redisScanner interface {
+	Scan(ctx context.Context, cursor uint64, match string, count int64) *redis.ScanCmd
 }
 
 const (
@@ -25,8 +45,57 @@ const (
 	RedisType = "redis"
 	// RedisTagPattern represents the tag pattern to be used as a key in specified storage
 	RedisTagPattern = "gocache_tag_%s"
+	// RedisScanCount represents the number of keys retrieved by each SCAN command
+	RedisScanCount = 100
+	// RedisVersionPattern represents the prefix of the keys holding the
+	// versions of the values read with GetWithVersion
+	RedisVersionPattern = "gocache_version_%s"
 )
 
+// redisKeyMissing is the TTL returned by Redis for keys which do not exist
+const redisKeyMissing = time.Duration(-2)
+
+// redisGetWithVersionScript returns a value along with its version, which is
+// given the new version token when the value has none yet. The version
+// expires along with the value.
+const redisGetWithVersionScript = `local value = redis.call("GET", KEYS[1])
+if not value then
+	return false
+end
+local version = redis.call("GET", KEYS[2])
+if not version then
+	version = ARGV[1]
+	local ttl = redis.call("PTTL", KEYS[1])
+	if ttl > 0 then
+		redis.call("SET", KEYS[2], version, "PX", ttl)
+	else
+		redis.call("SET", KEYS[2], version)
+	end
+end
+return {value, version}`
+
+// redisSetIfVersionScript sets a value only when its version matches the given
+// one, with an optional expiration in milliseconds, and drops its version. The
+// current expiration is kept when none is given.
+const redisSetIfVersionScript = `if redis.call("EXISTS", KEYS[1]) == 0 or redis.call("GET", KEYS[2]) ~= ARGV[1] then
+	return 0
+end
+if tonumber(ARGV[3]) > 0 then
+	redis.call("SET", KEYS[1], ARGV[2], "PX", ARGV[3])
+else
+	redis.call("SET", KEYS[1], ARGV[2], "KEEPTTL")
+end
+redis.call("DEL", KEYS[2])
+return 1`
+
+// redisDeleteIfVersionScript deletes a value and its version only when its
+// version matches the given one
+const redisDeleteIfVersionScript = `if redis.call("EXISTS", KEYS[1]) == 0 or redis.call("GET", KEYS[2]) ~= ARGV[1] then
+	return 0
+end
+redis.call("DEL", KEYS[1], KEYS[2])
+return 1`
+
 // RedisStore is a store for Redis
 type RedisStore struct {
 	client  RedisClientInterface
@@ -43,7 +112,12 @@ func NewRedis(client RedisClientInterface, options ...Option) *RedisStore {
 
 // Get returns data stored from a given key
 func (s *RedisStore) Get(ctx context.Context, key any) (any, error) {
-	object, err := s.client.Get(ctx, key.(string)).Result()
+	cacheKey, err := s.key(key)
+	if err != nil {
+		return nil, err
+	}
+
+	object, err := s.client.Get(ctx, cacheKey).Result()
 	if err == redis.Nil {
 		return nil, NotFoundWithCause(err)
 	}
@@ -52,7 +126,12 @@ func (s *RedisStore) Get(ctx context.Context, key any) (any, error) {
 
 // GetWithTTL returns data stored from a given key and its corresponding TTL
 func (s *RedisStore) GetWithTTL(ctx context.Context, key any) (any, time.Duration, error) {
-	object, err := s.client.Get(ctx, key.(string)).Result()
+	cacheKey, err := s.key(key)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	object, err := s.client.Get(ctx, cacheKey).Result()
 	if err == redis.Nil {
 		return nil, 0, NotFoundWithCause(err)
 	}
@@ -60,7 +139,7 @@ func (s *RedisStore) GetWithTTL(ctx context.Context, key any) (any, time.Duratio
 		return nil, 0, err
 	}
 
-	ttl, err := s.client.TTL(ctx, key.(string)).Result()
+	ttl, err := s.client.TTL(ctx, cacheKey).Result()
 	if err != nil {
 		return nil, 0, err
 	}
@@ -68,11 +147,25 @@ func (s *RedisStore) GetWithTTL(ctx context.Context, key any) (any, time.Duratio
 	return object, ttl, err
 }
 
-// Set defines data in Redis for given key identifier
+// Set defines data in Redis for given key identifier, dropping the version of
+// its previous value in the same transaction
 func (s *RedisStore) Set(ctx context.Context, key any, value any, options ...Option) error {
+	cacheKey, err := s.key(key)
+	if err != nil {
+		return err
+	}
+
+	if err := checkRedisValue(value); err != nil {
+		return err
+	}
+
 	opts := applyOptionsWithDefault(s.options, options...)
 
-	err := s.client.Set(ctx, key.(string), value, opts.expiration).Err()
+	_, err = s.client.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.Set(ctx, cacheKey, value, opts.expiration)
+		pipe.Del(ctx, redisVersionKey(cacheKey))
+		return nil
+	})
 	if err != nil {
 		return err
 	}
@@ -85,27 +178,360 @@ func (s *RedisStore) Set(ctx context.Context, key any, value any, options ...Opt
 }
 
 func (s *RedisStore) setTags(ctx context.Context, key any, tags []string) {
+	member, err := keyString(key)
+	if err != nil {
+		return
+	}
+
 	for _, tag := range tags {
 		tagKey := fmt.Sprintf(RedisTagPattern, tag)
-		s.client.SAdd(ctx, tagKey, key.(string))
-		s.client.Expire(ctx, tagKey, 720*time.Hour)
+		s.client.SAdd(ctx, s.options.namespacedKey(tagKey), member)
+		s.client.Expire(ctx, s.options.namespacedKey(tagKey), 720*time.Hour)
 	}
 }
 
 // Delete removes data from Redis for given key identifier
 func (s *RedisStore) Delete(ctx context.Context, key any) error {
-	_, err := s.client.Del(ctx, key.(string)).Result()
+	cacheKey, err := s.key(key)
+	if err != nil {
+		return err
+	}
+
+	_, err = s.client.Del(ctx, cacheKey, redisVersionKey(cacheKey)).Result()
 	return err
 }
 
+// GetMany returns data stored from given keys using a single MGET command
+func (s *RedisStore) GetMany(ctx context.Context, keys []any) (map[any]any, error) {
+	values := make(map[any]any, len(keys))
+	if len(keys) == 0 {
+		return values, nil
+	}
+
+	cacheKeys, err := s.keys(keys)
+	if err != nil {
+		return nil, err
+	}
+
+	objects, err := s.client.MGet(ctx, cacheKeys...).Result()
+	if err != nil {
+		return nil, err
+	}
+
+	for i, object := range objects {
+		if object != nil {
+			values[keys[i]] = object
+		}
+	}
+
+	return values, nil
+}
+
+// GetManyWithTTL returns data stored from given keys along with their
+// remaining TTL, using GET and TTL commands sent in a single pipeline
+func (s *RedisStore) GetManyWithTTL(ctx context.Context, keys []any) (map[any]any, map[any]time.Duration, error) {
+	cacheKeys, err := s.keys(keys)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return getManyWithTTL(ctx, s.client.Pipelined, keys, cacheKeys)
+}
+
+// getManyWithTTL returns data stored from given keys along with their
+// remaining TTL, using GET and TTL commands sent in a single pipeline of the
+// given client. Keys which expired between both commands are omitted.
+func getManyWithTTL(ctx context.Context, pipelined func(ctx context.Context, fn func(redis.Pipeliner) error) ([]redis.Cmder, error), keys []any, cacheKeys []string) (map[any]any, map[any]time.Duration, error) {
+	values := make(map[any]any, len(keys))
+	ttls := make(map[any]time.Duration, len(keys))
+	if len(keys) == 0 {
+		return values, ttls, nil
+	}
+
+	getCmds := make([]*redis.StringCmd, len(keys))
+	ttlCmds := make([]*redis.DurationCmd, len(keys))
+	_, err := pipelined(ctx, func(pipe redis.Pipeliner) error {
+		for i, cacheKey := range cacheKeys {
+			getCmds[i] = pipe.Get(ctx, cacheKey)
+			ttlCmds[i] = pipe.TTL(ctx, cacheKey)
+		}
+		return nil
+	})
+	if err != nil && err != redis.Nil {
+		return nil, nil, err
+	}
+
+	for i, getCmd := range getCmds {
+		object, err := getCmd.Result()
+		if err == redis.Nil {
+			continue
+		}
+		if err != nil {
+			return nil, nil, err
+		}
+
+		ttl, err := ttlCmds[i].Result()
+		if err != nil {
+			return nil, nil, err
+		}
+		if ttl == redisKeyMissing {
+			continue
+		}
+
+		values[keys[i]] = object
+		ttls[keys[i]] = ttl
+	}
+
+	return values, ttls, nil
+}
+
+// SetMany defines data in Redis for given key identifiers using a
+// transactional pipeline, which also drops the versions of previous values
+func (s *RedisStore) SetMany(ctx context.Context, items map[any]any, options ...Option) error {
+	opts := applyOptionsWithDefault(s.options, options...)
+
+	cacheItems, err := s.items(items)
+	if err != nil {
+		return err
+	}
+
+	_, err = s.client.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		versionKeys := make([]string, 0, len(cacheItems))
+		for cacheKey, value := range cacheItems {
+			pipe.Set(ctx, cacheKey, value, opts.expiration)
+			versionKeys = append(versionKeys, redisVersionKey(cacheKey))
+		}
+		if len(versionKeys) > 0 {
+			pipe.Del(ctx, versionKeys...)
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	if tags := opts.tags; len(tags) > 0 {
+		for key := range items {
+			s.setTags(ctx, key, tags)
+		}
+	}
+
+	return nil
+}
+
+// DeleteMany removes data from Redis for given key identifiers using a single DEL command
+func (s *RedisStore) DeleteMany(ctx context.Context, keys []any) error {
+	if len(keys) == 0 {
+		return nil
+	}
+
+	cacheKeys, err := s.keys(keys)
+	if err != nil {
+		return err
+	}
+
+	_, err = s.client.Del(ctx, withRedisVersionKeys(cacheKeys)...).Result()
+	return err
+}
+
+// SetIfNotExists defines data in Redis for given key identifier only when
+// the key does not exist yet, using the SETNX command
+func (s *RedisStore) SetIfNotExists(ctx context.Context, key any, value any, options ...Option) error {
+	cacheKey, err := s.key(key)
+	if err != nil {
+		return err
+	}
+
+	if err := checkRedisValue(value); err != nil {
+		return err
+	}
+
+	opts := applyOptionsWithDefault(s.options, options...)
+
+	set, err := s.client.SetNX(ctx, cacheKey, value, opts.expiration).Result()
+	if err != nil {
+		return err
+	}
+	if !set {
+		return AlreadyExistsWithCause(errors.New("value already exists in Redis store"))
+	}
+
+	// Drop the version left by a previous value which expired meanwhile
+	if err := s.client.Del(ctx, redisVersionKey(cacheKey)).Err(); err != nil {
+		return err
+	}
+
+	if tags := opts.tags; len(tags) > 0 {
+		s.setTags(ctx, key, tags)
+	}
+
+	return nil
+}
+
+// GetWithVersion returns data stored from a given key and its version, which
+// is kept in a separate key until the value is written, using a Lua script
+func (s *RedisStore) GetWithVersion(ctx context.Context, key any) (any, Version, error) {
+	cacheKey, err := s.key(key)
+	if err != nil {
+		return nil, Version{}, err
+	}
+
+	return redisGetWithVersion(ctx, s.client.Eval, cacheKey)
+}
+
+// SetIfVersion defines data in Redis for given key identifier only when its
+// value has not been written since the given version, using a Lua script.
+// The current expiration is kept when none is given.
+func (s *RedisStore) SetIfVersion(ctx context.Context, key any, value any, version Version, options ...Option) error {
+	current, ok := version.token.(string)
+	if !ok {
+		return ErrInvalidVersion
+	}
+
+	cacheKey, err := s.key(key)
+	if err != nil {
+		return err
+	}
+
+	if err := checkRedisValue(value); err != nil {
+		return err
+	}
+
+	opts := applyOptionsWithDefault(s.options, options...)
+
+	set, err := s.client.Eval(ctx, redisSetIfVersionScript, []string{cacheKey, redisVersionKey(cacheKey)}, current, value, opts.expiration.Milliseconds()).Int()
+	if err != nil {
+		return err
+	}
+	if set == 0 {
+		return VersionConflictWithCause(errors.New("value has changed in Redis store"))
+	}
+
+	if tags := opts.tags; len(tags) > 0 {
+		s.setTags(ctx, key, tags)
+	}
+
+	return nil
+}
+
+// DeleteIfVersion removes data from Redis for given key identifier only when
+// its value has not been written since the given version, using a Lua script
+func (s *RedisStore) DeleteIfVersion(ctx context.Context, key any, version Version) error {
+	current, ok := version.token.(string)
+	if !ok {
+		return ErrInvalidVersion
+	}
+
+	cacheKey, err := s.key(key)
+	if err != nil {
+		return err
+	}
+
+	deleted, err := s.client.Eval(ctx, redisDeleteIfVersionScript, []string{cacheKey, redisVersionKey(cacheKey)}, current).Int()
+	if err != nil {
+		return err
+	}
+	if deleted == 0 {
+		return VersionConflictWithCause(errors.New("value has changed in Redis store"))
+	}
+
+	return nil
+}
+
+// Exists returns whether the given key exists in Redis, using the EXISTS command
+func (s *RedisStore) Exists(ctx context.Context, key any) (bool, error) {
+	cacheKey, err := s.key(key)
+	if err != nil {
+		return false, err
+	}
+
+	count, err := s.client.Exists(ctx, cacheKey).Result()
+	if err != nil {
+		return false, err
+	}
+
+	return count > 0, nil
+}
+
+// Touch updates the expiration of the given key in Redis using the EXPIRE
+// command, or the PERSIST command when ttl is 0
+func (s *RedisStore) Touch(ctx context.Context, key any, ttl time.Duration) error {
+	cacheKey, err := s.key(key)
+	if err != nil {
+		return err
+	}
+
+	var cmd *redis.BoolCmd
+	if ttl > 0 {
+		cmd = s.client.Expire(ctx, cacheKey, ttl)
+	} else {
+		cmd = s.client.Persist(ctx, cacheKey)
+	}
+
+	touched, err := cmd.Result()
+	if err != nil {
+		return err
+	}
+	if !touched {
+		// PERSIST also returns false for existing keys without expiration
+		if exists, err := s.Exists(ctx, key); err != nil || exists {
+			return err
+		}
+
+		return NotFoundWithCause(errors.New("value not found in Redis store"))
+	}
+
+	return nil
+}
+
+// Increment atomically adds delta to the integer stored at the given key using
+// the INCRBY command. When an expiration is given, the key is first created with
+// it using SETNX so that following increments keep the initial expiration.
+// The version of the previous value is dropped in the same transaction as
+// INCRBY.
+func (s *RedisStore) Increment(ctx context.Context, key any, delta int64, options ...Option) (int64, error) {
+	cacheKey, err := s.key(key)
+	if err != nil {
+		return 0, err
+	}
+
+	opts := applyOptionsWithDefault(s.options, options...)
+
+	if opts.expiration > 0 {
+		if err := s.client.SetNX(ctx, cacheKey, 0, opts.expiration).Err(); err != nil {
+			return 0, err
+		}
+	}
+
+	var counter *redis.IntCmd
+	_, err = s.client.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		counter = pipe.IncrBy(ctx, cacheKey, delta)
+		pipe.Del(ctx, redisVersionKey(cacheKey))
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return counter.Val(), nil
+}
+
 // Invalidate invalidates some cache data in Redis for given options
 func (s *RedisStore) Invalidate(ctx context.Context, options ...InvalidateOption) error {
 	opts := applyInvalidateOptions(options...)
 
+	if pattern := opts.pattern; pattern != "" {
+		deleted, err := deleteMatching(ctx, s, pattern, RedisScanCount, s.unlink)
+		opts.addDeleted(deleted)
+		if err != nil {
+			return err
+		}
+	}
+
 	if tags := opts.tags; len(tags) > 0 {
 		for _, tag := range tags {
 			tagKey := fmt.Sprintf(RedisTagPattern, tag)
-			cacheKeys, err := s.client.SMembers(ctx, tagKey).Result()
+			cacheKeys, err := s.client.SMembers(ctx, s.options.namespacedKey(tagKey)).Result()
 			if err != nil {
 				continue
 			}
@@ -121,16 +547,204 @@ func (s *RedisStore) Invalidate(ctx context.Context, options ...InvalidateOption
 	return nil
 }
 
+// Close closes the Redis client, when it can be closed
+func (s *RedisStore) Close() error {
+	if closer, ok := s.client.(io.Closer); ok {
+		return closer.Close()
+	}
+
+	return nil
+}
+
 // GetType returns the store type
 func (s *RedisStore) GetType() string {
 	return RedisType
 }
 
-// Clear resets all data in the store
+// Scan calls fn for each key matching the given pattern using the SCAN
+// command, which retrieves keys in batches with a cursor. Only the keys of the
+// store namespace are scanned and given without their namespace prefix.
+func (s *RedisStore) Scan(ctx context.Context, pattern string, fn func(key string) error) error {
+	return redisScan(ctx, s.client, s.options.namespacedPattern(pattern), s.options.unnamespacedScan(fn))
+}
+
+// Clear resets all data of the store namespace using the SCAN and UNLINK
+// commands. Stores without namespace flush the whole Redis server when the
+// WithFlushAll option is given and return ErrNamespaceRequired otherwise.
 func (s *RedisStore) Clear(ctx context.Context) error {
-	if err := s.client.FlushAll(ctx).Err(); err != nil {
-		return err
+	if s.options.namespace == "" {
+		if !s.options.flushAll {
+			return ErrNamespaceRequired
+		}
+
+		if err := s.client.FlushAll(ctx).Err(); err != nil {
+			return err
+		}
+
+		return nil
 	}
 
-	return nil
+	_, err := deleteMatching(ctx, s, "*", RedisScanCount, s.unlink)
+	return err
+}
+
+// key returns the Redis key of the given key identifier in the store namespace
+func (s *RedisStore) key(key any) (string, error) {
+	k, err := keyString(key)
+	if err != nil {
+		return "", err
+	}
+
+	return s.options.namespacedKey(k), nil
+}
+
+// keys returns the Redis keys of the given key identifiers in the store namespace
+func (s *RedisStore) keys(keys []any) ([]string, error) {
+	cacheKeys := make([]string, len(keys))
+	for i, key := range keys {
+		cacheKey, err := s.key(key)
+		if err != nil {
+			return nil, err
+		}
+		cacheKeys[i] = cacheKey
+	}
+
+	return cacheKeys, nil
+}
+
+// items returns the given items indexed by their Redis keys in the store
+// namespace after checking their values can be sent to Redis
+func (s *RedisStore) items(items map[any]any) (map[string]any, error) {
+	cacheItems := make(map[string]any, len(items))
+	for key, value := range items {
+		cacheKey, err := s.key(key)
+		if err != nil {
+			return nil, err
+		}
+		if err := checkRedisValue(value); err != nil {
+			return nil, err
+		}
+		cacheItems[cacheKey] = value
+	}
+
+	return cacheItems, nil
+}
+
+// unlink removes the given keys of the store namespace using a single UNLINK
+// command, and their versions using another one sent in the same pipeline
+func (s *RedisStore) unlink(ctx context.Context, keys []string) (int64, error) {
+	cacheKeys := make([]string, len(keys))
+	versionKeys := make([]string, len(keys))
+	for i, key := range keys {
+		cacheKeys[i] = s.options.namespacedKey(key)
+		versionKeys[i] = redisVersionKey(cacheKeys[i])
+	}
+
+	var deleted *redis.IntCmd
+	_, err := s.client.Pipelined(ctx, func(pipe redis.Pipeliner) error {
+		deleted = pipe.Unlink(ctx, cacheKeys...)
+		pipe.Unlink(ctx, versionKeys...)
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return deleted.Val(), nil
+}
+
+// checkRedisValue returns ErrUnsupportedValueType when the given value cannot
+// be sent to Redis, which only handles strings, bytes, numbers, booleans, times,
+// durations and encoding.BinaryMarshaler implementations
+func checkRedisValue(value any) error {
+	switch value.(type) {
+	case nil, string, []byte, bool, time.Time, time.Duration, encoding.BinaryMarshaler,
+		int, int8, int16, int32, int64, uint, uint8, uint16, uint32, uint64, float32, float64:
+		return nil
+	}
+
+	return fmt.Errorf("%w: %T", ErrUnsupportedValueType, value)
+}
+
+// redisVersionKey returns the key holding the version of the value stored at
+// the given Redis key. It belongs to the same cluster hash slot, by using the
+// hash tag of the key or the key itself as hash tag, unless the key holds a
+// closing brace without being hash tagged.
+func redisVersionKey(cacheKey string) string {
+	if start := strings.IndexByte(cacheKey, '{'); start >= 0 {
+		if end := strings.IndexByte(cacheKey[start+1:], '}'); end > 0 {
+			return fmt.Sprintf(RedisVersionPattern, cacheKey)
+		}
+	}
+
+	return fmt.Sprintf(RedisVersionPattern, "{"+cacheKey+"}")
+}
+
+// withRedisVersionKeys returns the given Redis keys followed by the keys
+// holding their versions
+func withRedisVersionKeys(cacheKeys []string) []string {
+	keys := make([]string, 0, 2*len(cacheKeys))
+	keys = append(keys, cacheKeys...)
+	for _, cacheKey := range cacheKeys {
+		keys = append(keys, redisVersionKey(cacheKey))
+	}
+
+	return keys
+}
+
+// redisGetWithVersion returns the value stored at the given Redis key along
+// with its version, using the given Eval function of a client
+func redisGetWithVersion(ctx context.Context, eval func(ctx context.Context, script string, keys []string, args ...any) *redis.Cmd, cacheKey string) (any, Version, error) {
+	token, err := newVersionToken()
+	if err != nil {
+		return nil, Version{}, err
+	}
+
+	result, err := eval(ctx, redisGetWithVersionScript, []string{cacheKey, redisVersionKey(cacheKey)}, token).Slice()
+	if err == redis.Nil {
+		return nil, Version{}, NotFoundWithCause(err)
+	}
+	if err != nil {
+		return nil, Version{}, err
+	}
+	if len(result) != 2 {
+		return nil, Version{}, fmt.Errorf("unexpected reply of %d values to get a version", len(result))
+	}
+
+	version, ok := result[1].(string)
+	if !ok {
+		return nil, Version{}, fmt.Errorf("unexpected version reply of type %T", result[1])
+	}
+
+	return result[0], Version{token: version}, nil
+}
+
+// redisScan iterates over the keys of the given client matching the pattern
+func redisScan(ctx context.Context, client redisScanner, pattern string, fn func(key string) error) error {
+	if pattern == "" {
+		pattern = "*"
+	}
+
+	var cursor uint64
+	for {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		keys, next, err := client.Scan(ctx, cursor, pattern, RedisScanCount).Result()
+		if err != nil {
+			return err
+		}
+
+		for _, key := range keys {
+			if err := fn(key); err != nil {
+				return err
+			}
+		}
+
+		if next == 0 {
+			return nil
+		}
+		cursor = next
+	}
 }
@@ -2,6 +2,7 @@ package store
 
 import (
 	"context"
+	"errors"
 	"testing"
 	"time"
 
@@ -54,8 +55,10 @@ func TestRedisSet(t *testing.T) {
 	cacheKey := "my-key"
 	cacheValue := "my-cache-value"
 
+	pipe := newTestPipeliner(nil)
+
 	client := mocksStore.NewMockRedisClientInterface(ctrl)
-	client.EXPECT().Set(ctx, "my-key", cacheValue, 5*time.Second).Return(&redis.StatusCmd{})
+	client.EXPECT().TxPipelined(ctx, gomock.Any()).DoAndReturn(pipe.pipelined)
 
 	store := NewRedis(client, WithExpiration(6*time.Second))
 
@@ -64,6 +67,9 @@ func TestRedisSet(t *testing.T) {
 
 	// Then
 	assert.Nil(t, err)
+	assert.Equal(t, map[string]any{"my-key": "my-cache-value"}, pipe.sets)
+	assert.Equal(t, map[string]time.Duration{"my-key": 5 * time.Second}, pipe.expirations)
+	assert.Equal(t, []string{"gocache_version_{my-key}"}, pipe.deletes)
 }
 
 func TestRedisSetWhenNoOptionsGiven(t *testing.T) {
@@ -75,8 +81,10 @@ func TestRedisSetWhenNoOptionsGiven(t *testing.T) {
 	cacheKey := "my-key"
 	cacheValue := "my-cache-value"
 
+	pipe := newTestPipeliner(nil)
+
 	client := mocksStore.NewMockRedisClientInterface(ctrl)
-	client.EXPECT().Set(ctx, "my-key", cacheValue, 6*time.Second).Return(&redis.StatusCmd{})
+	client.EXPECT().TxPipelined(ctx, gomock.Any()).DoAndReturn(pipe.pipelined)
 
 	store := NewRedis(client, WithExpiration(6*time.Second))
 
@@ -85,6 +93,9 @@ func TestRedisSetWhenNoOptionsGiven(t *testing.T) {
 
 	// Then
 	assert.Nil(t, err)
+	assert.Equal(t, map[string]any{"my-key": "my-cache-value"}, pipe.sets)
+	assert.Equal(t, map[string]time.Duration{"my-key": 6 * time.Second}, pipe.expirations)
+	assert.Equal(t, []string{"gocache_version_{my-key}"}, pipe.deletes)
 }
 
 func TestRedisSetWithTags(t *testing.T) {
@@ -96,8 +107,10 @@ func TestRedisSetWithTags(t *testing.T) {
 	cacheKey := "my-key"
 	cacheValue := "my-cache-value"
 
+	pipe := newTestPipeliner(nil)
+
 	client := mocksStore.NewMockRedisClientInterface(ctrl)
-	client.EXPECT().Set(ctx, cacheKey, cacheValue, time.Duration(0)).Return(&redis.StatusCmd{})
+	client.EXPECT().TxPipelined(ctx, gomock.Any()).DoAndReturn(pipe.pipelined)
 	client.EXPECT().SAdd(ctx, "gocache_tag_tag1", "my-key").Return(&redis.IntCmd{})
 	client.EXPECT().Expire(ctx, "gocache_tag_tag1", 720*time.Hour).Return(&redis.BoolCmd{})
 
@@ -108,6 +121,9 @@ func TestRedisSetWithTags(t *testing.T) {
 
 	// Then
 	assert.Nil(t, err)
+	assert.Equal(t, map[string]any{"my-key": "my-cache-value"}, pipe.sets)
+	assert.Equal(t, map[string]time.Duration{"my-key": time.Duration(0)}, pipe.expirations)
+	assert.Equal(t, []string{"gocache_version_{my-key}"}, pipe.deletes)
 }
 
 func TestRedisDelete(t *testing.T) {
@@ -119,7 +135,7 @@ func TestRedisDelete(t *testing.T) {
 	cacheKey := "my-key"
 
 	client := mocksStore.NewMockRedisClientInterface(ctrl)
-	client.EXPECT().Del(ctx, "my-key").Return(&redis.IntCmd{})
+	client.EXPECT().Del(ctx, "my-key", "gocache_version_{my-key}").Return(&redis.IntCmd{})
 
 	store := NewRedis(client)
 
@@ -130,6 +146,450 @@ func TestRedisDelete(t *testing.T) {
 	assert.Nil(t, err)
 }
 
+// testPipeliner is a redis pipeliner that records queued commands instead
+// of sending them to a Redis server
+type testPipeliner struct {
+	redis.Pipeliner
+	values      map[string]string
+	sets        map[string]any
+	expirations map[string]time.Duration
+	increments  map[string]int64
+	deletes     []string
+	unlinks     []string
+}
+
+func newTestPipeliner(values map[string]string) *testPipeliner {
+	return &testPipeliner{
+		values:      values,
+		sets:        map[string]any{},
+		expirations: map[string]time.Duration{},
+		increments:  map[string]int64{},
+	}
+}
+
+func (p *testPipeliner) Get(_ context.Context, key string) *redis.StringCmd {
+	if value, ok := p.values[key]; ok {
+		return redis.NewStringResult(value, nil)
+	}
+	return redis.NewStringResult("", redis.Nil)
+}
+
+func (p *testPipeliner) TTL(_ context.Context, key string) *redis.DurationCmd {
+	if _, ok := p.values[key]; ok {
+		return redis.NewDurationResult(5*time.Second, nil)
+	}
+	return redis.NewDurationResult(redisKeyMissing, nil)
+}
+
+func (p *testPipeliner) Set(_ context.Context, key string, value any, expiration time.Duration) *redis.StatusCmd {
+	p.sets[key] = value
+	p.expirations[key] = expiration
+	return redis.NewStatusResult("OK", nil)
+}
+
+func (p *testPipeliner) IncrBy(_ context.Context, key string, delta int64) *redis.IntCmd {
+	p.increments[key] += delta
+	return redis.NewIntResult(p.increments[key], nil)
+}
+
+func (p *testPipeliner) Del(_ context.Context, keys ...string) *redis.IntCmd {
+	p.deletes = append(p.deletes, keys...)
+	return redis.NewIntResult(int64(len(keys)), nil)
+}
+
+func (p *testPipeliner) Unlink(_ context.Context, keys ...string) *redis.IntCmd {
+	var unlinked int64
+	for _, key := range keys {
+		p.unlinks = append(p.unlinks, key)
+		if _, ok := p.values[key]; ok {
+			unlinked++
+		}
+	}
+	return redis.NewIntResult(unlinked, nil)
+}
+
+func (p *testPipeliner) pipelined(_ context.Context, fn func(redis.Pipeliner) error) ([]redis.Cmder, error) {
+	return nil, fn(p)
+}
+
+func TestRedisGetMany(t *testing.T) {
+	// Given
+	ctrl := gomock.NewController(t)
+
+	ctx := context.Background()
+
+	client := mocksStore.NewMockRedisClientInterface(ctrl)
+	client.EXPECT().MGet(ctx, "key-1", "key-2").Return(redis.NewSliceResult([]any{"value 1", nil}, nil))
+
+	store := NewRedis(client)
+
+	// When
+	values, err := store.GetMany(ctx, []any{"key-1", "key-2"})
+
+	// Then
+	assert.Nil(t, err)
+	assert.Equal(t, map[any]any{"key-1": "value 1"}, values)
+}
+
+func TestRedisGetManyWithTTL(t *testing.T) {
+	// Given
+	ctrl := gomock.NewController(t)
+
+	ctx := context.Background()
+
+	pipe := newTestPipeliner(map[string]string{"key-1": "value 1"})
+
+	client := mocksStore.NewMockRedisClientInterface(ctrl)
+	client.EXPECT().Pipelined(ctx, gomock.Any()).DoAndReturn(pipe.pipelined)
+
+	store := NewRedis(client)
+
+	// When
+	values, ttls, err := store.GetManyWithTTL(ctx, []any{"key-1", "key-2"})
+
+	// Then
+	assert.Nil(t, err)
+	assert.Equal(t, map[any]any{"key-1": "value 1"}, values)
+	assert.Equal(t, map[any]time.Duration{"key-1": 5 * time.Second}, ttls)
+}
+
+func TestRedisSetMany(t *testing.T) {
+	// Given
+	ctrl := gomock.NewController(t)
+
+	ctx := context.Background()
+
+	pipe := newTestPipeliner(nil)
+
+	client := mocksStore.NewMockRedisClientInterface(ctrl)
+	client.EXPECT().TxPipelined(ctx, gomock.Any()).DoAndReturn(pipe.pipelined)
+
+	store := NewRedis(client)
+
+	// When
+	err := store.SetMany(ctx, map[any]any{"key-1": "value 1", "key-2": "value 2"}, WithExpiration(5*time.Second))
+
+	// Then
+	assert.Nil(t, err)
+	assert.Equal(t, map[string]any{"key-1": "value 1", "key-2": "value 2"}, pipe.sets)
+	assert.ElementsMatch(t, []string{"gocache_version_{key-1}", "gocache_version_{key-2}"}, pipe.deletes)
+}
+
+func TestRedisDeleteMany(t *testing.T) {
+	// Given
+	ctrl := gomock.NewController(t)
+
+	ctx := context.Background()
+
+	client := mocksStore.NewMockRedisClientInterface(ctrl)
+	client.EXPECT().Del(ctx, "key-1", "key-2", "gocache_version_{key-1}", "gocache_version_{key-2}").Return(&redis.IntCmd{})
+
+	store := NewRedis(client)
+
+	// When
+	err := store.DeleteMany(ctx, []any{"key-1", "key-2"})
+
+	// Then
+	assert.Nil(t, err)
+}
+
+func TestRedisSetIfNotExists(t *testing.T) {
+	// Given
+	ctrl := gomock.NewController(t)
+
+	ctx := context.Background()
+
+	client := mocksStore.NewMockRedisClientInterface(ctrl)
+	client.EXPECT().SetNX(ctx, "my-key", "my-value", 5*time.Second).Return(redis.NewBoolResult(true, nil))
+	client.EXPECT().Del(ctx, "gocache_version_{my-key}").Return(redis.NewIntResult(0, nil))
+
+	store := NewRedis(client, WithExpiration(5*time.Second))
+
+	// When
+	err := store.SetIfNotExists(ctx, "my-key", "my-value")
+
+	// Then
+	assert.Nil(t, err)
+}
+
+func TestRedisSetIfNotExistsWhenAlreadyExists(t *testing.T) {
+	// Given
+	ctrl := gomock.NewController(t)
+
+	ctx := context.Background()
+
+	client := mocksStore.NewMockRedisClientInterface(ctrl)
+	client.EXPECT().SetNX(ctx, "my-key", "my-value", time.Duration(0)).Return(redis.NewBoolResult(false, nil))
+
+	store := NewRedis(client)
+
+	// When
+	err := store.SetIfNotExists(ctx, "my-key", "my-value", WithTags([]string{"tag1"}))
+
+	// Then
+	assert.ErrorIs(t, err, &AlreadyExists{})
+}
+
+func TestRedisGetWithVersion(t *testing.T) {
+	// Given
+	ctrl := gomock.NewController(t)
+
+	ctx := context.Background()
+
+	var tokens []any
+
+	client := mocksStore.NewMockRedisClientInterface(ctrl)
+	client.EXPECT().Eval(ctx, redisGetWithVersionScript, []string{"my-key", "gocache_version_{my-key}"}, gomock.Any()).
+		DoAndReturn(func(_ context.Context, _ string, _ []string, args ...any) *redis.Cmd {
+			tokens = append(tokens, args...)
+			return redis.NewCmdResult([]any{"value 1", "version 1"}, nil)
+		}).
+		Times(2)
+
+	store := NewRedis(client)
+
+	// When
+	value, version, err := store.GetWithVersion(ctx, "my-key")
+	_, _, _ = store.GetWithVersion(ctx, "my-key")
+
+	// Then
+	assert.Nil(t, err)
+	assert.Equal(t, "value 1", value)
+	assert.Equal(t, Version{token: "version 1"}, version)
+	assert.Len(t, tokens, 2)
+	assert.NotEqual(t, tokens[0], tokens[1])
+}
+
+func TestRedisGetWithVersionWhenNotFound(t *testing.T) {
+	// Given
+	ctrl := gomock.NewController(t)
+
+	ctx := context.Background()
+
+	client := mocksStore.NewMockRedisClientInterface(ctrl)
+	client.EXPECT().Eval(ctx, redisGetWithVersionScript, []string{"my-key", "gocache_version_{my-key}"}, gomock.Any()).
+		Return(redis.NewCmdResult(nil, redis.Nil))
+
+	store := NewRedis(client)
+
+	// When
+	value, _, err := store.GetWithVersion(ctx, "my-key")
+
+	// Then
+	assert.ErrorIs(t, err, &NotFound{})
+	assert.Nil(t, value)
+}
+
+func TestRedisVersionKey(t *testing.T) {
+	assert.Equal(t, "gocache_version_{my-key}", redisVersionKey("my-key"))
+	assert.Equal(t, "gocache_version_{user:1}:profile", redisVersionKey("{user:1}:profile"))
+	assert.Equal(t, "gocache_version_{{}:profile}", redisVersionKey("{}:profile"))
+}
+
+func TestRedisSetIfVersion(t *testing.T) {
+	// Given
+	ctrl := gomock.NewController(t)
+
+	ctx := context.Background()
+
+	keys := []string{"my-key", "gocache_version_{my-key}"}
+
+	client := mocksStore.NewMockRedisClientInterface(ctrl)
+	client.EXPECT().Eval(ctx, redisGetWithVersionScript, keys, gomock.Any()).
+		Return(redis.NewCmdResult([]any{"value 1", "version 1"}, nil))
+	client.EXPECT().Eval(ctx, redisSetIfVersionScript, keys, "version 1", "value 2", int64(5000)).
+		Return(redis.NewCmdResult(int64(1), nil))
+
+	store := NewRedis(client)
+
+	value, version, err := store.GetWithVersion(ctx, "my-key")
+	assert.Nil(t, err)
+	assert.Equal(t, "value 1", value)
+
+	// When
+	err = store.SetIfVersion(ctx, "my-key", "value 2", version, WithExpiration(5*time.Second))
+
+	// Then
+	assert.Nil(t, err)
+}
+
+func TestRedisSetIfVersionWhenConflict(t *testing.T) {
+	// Given
+	ctrl := gomock.NewController(t)
+
+	ctx := context.Background()
+
+	client := mocksStore.NewMockRedisClientInterface(ctrl)
+	client.EXPECT().Eval(ctx, redisSetIfVersionScript, []string{"my-key", "gocache_version_{my-key}"}, "version 1", "value 2", int64(0)).
+		Return(redis.NewCmdResult(int64(0), nil))
+
+	store := NewRedis(client)
+
+	// When
+	err := store.SetIfVersion(ctx, "my-key", "value 2", Version{token: "version 1"})
+
+	// Then
+	assert.ErrorIs(t, err, &VersionConflict{})
+}
+
+func TestRedisSetIfVersionWhenInvalidVersion(t *testing.T) {
+	// Given
+	ctrl := gomock.NewController(t)
+
+	ctx := context.Background()
+
+	client := mocksStore.NewMockRedisClientInterface(ctrl)
+
+	store := NewRedis(client)
+
+	// When
+	err := store.SetIfVersion(ctx, "my-key", "value 2", Version{})
+
+	// Then
+	assert.Equal(t, ErrInvalidVersion, err)
+}
+
+func TestRedisDeleteIfVersion(t *testing.T) {
+	// Given
+	ctrl := gomock.NewController(t)
+
+	ctx := context.Background()
+
+	client := mocksStore.NewMockRedisClientInterface(ctrl)
+	client.EXPECT().Eval(ctx, redisDeleteIfVersionScript, []string{"my-key", "gocache_version_{my-key}"}, "version 1").
+		Return(redis.NewCmdResult(int64(1), nil))
+
+	store := NewRedis(client)
+
+	// When
+	err := store.DeleteIfVersion(ctx, "my-key", Version{token: "version 1"})
+
+	// Then
+	assert.Nil(t, err)
+}
+
+func TestRedisExists(t *testing.T) {
+	// Given
+	ctrl := gomock.NewController(t)
+
+	ctx := context.Background()
+
+	client := mocksStore.NewMockRedisClientInterface(ctrl)
+	client.EXPECT().Exists(ctx, "my-key").Return(redis.NewIntResult(1, nil))
+
+	store := NewRedis(client)
+
+	// When
+	exists, err := store.Exists(ctx, "my-key")
+
+	// Then
+	assert.Nil(t, err)
+	assert.True(t, exists)
+}
+
+func TestRedisTouch(t *testing.T) {
+	// Given
+	ctrl := gomock.NewController(t)
+
+	ctx := context.Background()
+
+	client := mocksStore.NewMockRedisClientInterface(ctrl)
+	client.EXPECT().Expire(ctx, "my-key", 10*time.Second).Return(redis.NewBoolResult(true, nil))
+
+	store := NewRedis(client)
+
+	// When
+	err := store.Touch(ctx, "my-key", 10*time.Second)
+
+	// Then
+	assert.Nil(t, err)
+}
+
+func TestRedisTouchWhenNotFound(t *testing.T) {
+	// Given
+	ctrl := gomock.NewController(t)
+
+	ctx := context.Background()
+
+	client := mocksStore.NewMockRedisClientInterface(ctrl)
+	client.EXPECT().Expire(ctx, "my-key", 10*time.Second).Return(redis.NewBoolResult(false, nil))
+	client.EXPECT().Exists(ctx, "my-key").Return(redis.NewIntResult(0, nil))
+
+	store := NewRedis(client)
+
+	// When
+	err := store.Touch(ctx, "my-key", 10*time.Second)
+
+	// Then
+	assert.ErrorIs(t, err, &NotFound{})
+}
+
+func TestRedisTouchWithoutExpiration(t *testing.T) {
+	// Given
+	ctrl := gomock.NewController(t)
+
+	ctx := context.Background()
+
+	client := mocksStore.NewMockRedisClientInterface(ctrl)
+	client.EXPECT().Persist(ctx, "my-key").Return(redis.NewBoolResult(false, nil))
+	client.EXPECT().Exists(ctx, "my-key").Return(redis.NewIntResult(1, nil))
+
+	store := NewRedis(client)
+
+	// When
+	err := store.Touch(ctx, "my-key", 0)
+
+	// Then
+	assert.Nil(t, err)
+}
+
+func TestRedisIncrement(t *testing.T) {
+	// Given
+	ctrl := gomock.NewController(t)
+
+	ctx := context.Background()
+
+	pipe := newTestPipeliner(nil)
+	pipe.increments["my-counter"] = 2
+
+	client := mocksStore.NewMockRedisClientInterface(ctrl)
+	client.EXPECT().TxPipelined(ctx, gomock.Any()).DoAndReturn(pipe.pipelined)
+
+	store := NewRedis(client)
+
+	// When
+	counter, err := store.Increment(ctx, "my-counter", 3)
+
+	// Then
+	assert.Nil(t, err)
+	assert.Equal(t, int64(5), counter)
+	assert.Equal(t, []string{"gocache_version_{my-counter}"}, pipe.deletes)
+}
+
+func TestRedisIncrementWithExpiration(t *testing.T) {
+	// Given
+	ctrl := gomock.NewController(t)
+
+	ctx := context.Background()
+
+	pipe := newTestPipeliner(nil)
+
+	client := mocksStore.NewMockRedisClientInterface(ctrl)
+	gomock.InOrder(
+		client.EXPECT().SetNX(ctx, "my-counter", 0, 10*time.Second).Return(redis.NewBoolResult(true, nil)),
+		client.EXPECT().TxPipelined(ctx, gomock.Any()).DoAndReturn(pipe.pipelined),
+	)
+
+	store := NewRedis(client)
+
+	// When
+	counter, err := store.Increment(ctx, "my-counter", -1, WithExpiration(10*time.Second))
+
+	// Then
+	assert.Nil(t, err)
+	assert.Equal(t, int64(-1), counter)
+}
+
 func TestRedisInvalidate(t *testing.T) {
 	// Given
 	ctrl := gomock.NewController(t)
@@ -151,6 +611,108 @@ func TestRedisInvalidate(t *testing.T) {
 	assert.Nil(t, err)
 }
 
+func TestRedisInvalidatePattern(t *testing.T) {
+	// Given
+	ctrl := gomock.NewController(t)
+
+	ctx := context.Background()
+
+	pipe := newTestPipeliner(map[string]string{"user:42:name": "John", "user:42:email": "john@example.com"})
+
+	client := mocksStore.NewMockRedisClientInterface(ctrl)
+	client.EXPECT().Scan(ctx, uint64(0), "user:42:*", int64(RedisScanCount)).
+		Return(redis.NewScanCmdResult([]string{"user:42:name", "user:42:email"}, 0, nil))
+	client.EXPECT().Pipelined(ctx, gomock.Any()).DoAndReturn(pipe.pipelined)
+
+	store := NewRedis(client)
+
+	// When
+	var deleted int64
+	err := store.Invalidate(ctx, WithInvalidatePattern("user:42:*"), WithInvalidateDeletedCount(&deleted))
+
+	// Then
+	assert.Nil(t, err)
+	assert.Equal(t, int64(2), deleted)
+	assert.Equal(t, []string{
+		"user:42:name", "user:42:email",
+		"gocache_version_{user:42:name}", "gocache_version_{user:42:email}",
+	}, pipe.unlinks)
+}
+
+func TestRedisScan(t *testing.T) {
+	// Given
+	ctrl := gomock.NewController(t)
+
+	ctx := context.Background()
+
+	client := mocksStore.NewMockRedisClientInterface(ctrl)
+	gomock.InOrder(
+		client.EXPECT().Scan(ctx, uint64(0), "my-*", int64(RedisScanCount)).
+			Return(redis.NewScanCmdResult([]string{"my-key1", "my-key2"}, 42, nil)),
+		client.EXPECT().Scan(ctx, uint64(42), "my-*", int64(RedisScanCount)).
+			Return(redis.NewScanCmdResult([]string{"my-key3"}, 0, nil)),
+	)
+
+	store := NewRedis(client)
+
+	// When
+	var keys []string
+	err := store.Scan(ctx, "my-*", func(key string) error {
+		keys = append(keys, key)
+		return nil
+	})
+
+	// Then
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"my-key1", "my-key2", "my-key3"}, keys)
+}
+
+func TestRedisScanWhenCallbackFails(t *testing.T) {
+	// Given
+	ctrl := gomock.NewController(t)
+
+	ctx := context.Background()
+
+	expectedErr := errors.New("unable to handle key")
+
+	client := mocksStore.NewMockRedisClientInterface(ctrl)
+	client.EXPECT().Scan(ctx, uint64(0), "*", int64(RedisScanCount)).
+		Return(redis.NewScanCmdResult([]string{"my-key1", "my-key2"}, 42, nil))
+
+	store := NewRedis(client)
+
+	// When
+	calls := 0
+	err := store.Scan(ctx, "", func(key string) error {
+		calls++
+		return expectedErr
+	})
+
+	// Then
+	assert.Equal(t, expectedErr, err)
+	assert.Equal(t, 1, calls)
+}
+
+func TestRedisScanWhenContextIsCanceled(t *testing.T) {
+	// Given
+	ctrl := gomock.NewController(t)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	client := mocksStore.NewMockRedisClientInterface(ctrl)
+
+	store := NewRedis(client)
+
+	// When
+	err := store.Scan(ctx, "*", func(key string) error {
+		return nil
+	})
+
+	// Then
+	assert.ErrorIs(t, err, context.Canceled)
+}
+
 func TestRedisClear(t *testing.T) {
 	// Given
 	ctrl := gomock.NewController(t)
@@ -160,7 +722,7 @@ func TestRedisClear(t *testing.T) {
 	client := mocksStore.NewMockRedisClientInterface(ctrl)
 	client.EXPECT().FlushAll(ctx).Return(&redis.StatusCmd{})
 
-	store := NewRedis(client)
+	store := NewRedis(client, WithFlushAll())
 
 	// When
 	err := store.Clear(ctx)
@@ -180,3 +742,117 @@ func TestRedisGetType(t *testing.T) {
 	// When - Then
 	assert.Equal(t, RedisType, store.GetType())
 }
+
+func TestRedisClose(t *testing.T) {
+	// Given
+	ctx := context.Background()
+
+	client := redis.NewClient(&redis.Options{Addr: "localhost:0"})
+
+	store := NewRedis(client)
+
+	// When
+	err := store.Close()
+
+	// Then
+	assert.Nil(t, err)
+	assert.Equal(t, redis.ErrClosed, client.Get(ctx, "my-key").Err())
+}
+
+func TestRedisCloseWhenClientCannotBeClosed(t *testing.T) {
+	// Given
+	ctrl := gomock.NewController(t)
+
+	client := mocksStore.NewMockRedisClientInterface(ctrl)
+
+	store := NewRedis(client)
+
+	// When - Then
+	assert.Nil(t, store.Close())
+}
+
+func TestRedisClearWithoutNamespace(t *testing.T) {
+	// Given
+	ctrl := gomock.NewController(t)
+
+	ctx := context.Background()
+
+	client := mocksStore.NewMockRedisClientInterface(ctrl)
+
+	store := NewRedis(client)
+
+	// When
+	err := store.Clear(ctx)
+
+	// Then
+	assert.Equal(t, ErrNamespaceRequired, err)
+}
+
+func TestRedisClearWithNamespace(t *testing.T) {
+	// Given
+	ctrl := gomock.NewController(t)
+
+	ctx := context.Background()
+
+	pipe := newTestPipeliner(nil)
+
+	client := mocksStore.NewMockRedisClientInterface(ctrl)
+	client.EXPECT().Scan(ctx, uint64(0), "my-app:*", int64(RedisScanCount)).
+		Return(redis.NewScanCmdResult([]string{"my-app:my-key1", "my-app:my-key2"}, 0, nil))
+	client.EXPECT().Pipelined(ctx, gomock.Any()).DoAndReturn(pipe.pipelined)
+
+	store := NewRedis(client, WithNamespace("my-app"))
+
+	// When
+	err := store.Clear(ctx)
+
+	// Then
+	assert.Nil(t, err)
+	assert.Equal(t, []string{
+		"my-app:my-key1", "my-app:my-key2",
+		"gocache_version_{my-app:my-key1}", "gocache_version_{my-app:my-key2}",
+	}, pipe.unlinks)
+}
+
+func TestRedisGetWithNamespace(t *testing.T) {
+	// Given
+	ctrl := gomock.NewController(t)
+
+	ctx := context.Background()
+
+	client := mocksStore.NewMockRedisClientInterface(ctrl)
+	client.EXPECT().Get(ctx, "my-app:my-key").Return(redis.NewStringResult("my-value", nil))
+
+	store := NewRedis(client, WithNamespace("my-app"))
+
+	// When
+	value, err := store.Get(ctx, "my-key")
+
+	// Then
+	assert.Nil(t, err)
+	assert.Equal(t, "my-value", value)
+}
+
+func TestRedisScanWithNamespace(t *testing.T) {
+	// Given
+	ctrl := gomock.NewController(t)
+
+	ctx := context.Background()
+
+	client := mocksStore.NewMockRedisClientInterface(ctrl)
+	client.EXPECT().Scan(ctx, uint64(0), "my-app:user:*", int64(RedisScanCount)).
+		Return(redis.NewScanCmdResult([]string{"my-app:user:42"}, 0, nil))
+
+	store := NewRedis(client, WithNamespace("my-app"))
+
+	// When
+	var keys []string
+	err := store.Scan(ctx, "user:*", func(key string) error {
+		keys = append(keys, key)
+		return nil
+	})
+
+	// Then
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"user:42"}, keys)
+}
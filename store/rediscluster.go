@@ -2,7 +2,10 @@ package store
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"io"
+	"sync"
 	"time"
 
 	"github.com/go-redis/redis/v8"
@@ -12,12 +15,21 @@ import (
 type RedisClusterClientInterface interface {
 	Get(ctx context.Context, key string) *redis.StringCmd
 	TTL(ctx context.Context, key string) *redis.DurationCmd
+	Exists(ctx context.Context, keys ...string) *redis.IntCmd
+	Persist(ctx context.Context, key string) *redis.BoolCmd
 	Expire(ctx context.Context, key string, expiration time.Duration) *redis.BoolCmd
 	Set(ctx context.Context, key string, values any, expiration time.Duration) *redis.StatusCmd
+	SetNX(ctx context.Context, key string, value any, expiration time.Duration) *redis.BoolCmd
+	IncrBy(ctx context.Context, key string, value int64) *redis.IntCmd
 	Del(ctx context.Context, keys ...string) *redis.IntCmd
+	Unlink(ctx context.Context, keys ...string) *redis.IntCmd
 	FlushAll(ctx context.Context) *redis.StatusCmd
 	SAdd(ctx context.Context, key string, members ...any) *redis.IntCmd
 	SMembers(ctx context.Context, key string) *redis.StringSliceCmd
+	Eval(ctx context.Context, script string, keys []string, args ...any) *redis.Cmd
+	Pipelined(ctx context.Context, fn func(redis.Pipeliner) error) ([]redis.Cmder, error)
+	TxPipelined(ctx context.Context, fn func(redis.Pipeliner) error) ([]redis.Cmder, error)
+	ForEachMaster(ctx context.Context, fn func(ctx context.Context, client *redis.Client) error) error
 }
 
 const (
@@ -43,7 +55,12 @@ func NewRedisCluster(client RedisClusterClientInterface, options ...Option) *Red
 
 // Get returns data stored from a given key
 func (s *RedisClusterStore) Get(ctx context.Context, key any) (any, error) {
-	object, err := s.clusclient.Get(ctx, key.(string)).Result()
+	cacheKey, err := s.key(key)
+	if err != nil {
+		return nil, err
+	}
+
+	object, err := s.clusclient.Get(ctx, cacheKey).Result()
 	if err == redis.Nil {
 		return nil, NotFoundWithCause(err)
 	}
@@ -52,7 +69,12 @@ func (s *RedisClusterStore) Get(ctx context.Context, key any) (any, error) {
 
 // GetWithTTL returns data stored from a given key and its corresponding TTL
 func (s *RedisClusterStore) GetWithTTL(ctx context.Context, key any) (any, time.Duration, error) {
-	object, err := s.clusclient.Get(ctx, key.(string)).Result()
+	cacheKey, err := s.key(key)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	object, err := s.clusclient.Get(ctx, cacheKey).Result()
 	if err == redis.Nil {
 		return nil, 0, NotFoundWithCause(err)
 	}
@@ -60,7 +82,7 @@ func (s *RedisClusterStore) GetWithTTL(ctx context.Context, key any) (any, time.
 		return nil, 0, err
 	}
 
-	ttl, err := s.clusclient.TTL(ctx, key.(string)).Result()
+	ttl, err := s.clusclient.TTL(ctx, cacheKey).Result()
 	if err != nil {
 		return nil, 0, err
 	}
@@ -68,11 +90,25 @@ func (s *RedisClusterStore) GetWithTTL(ctx context.Context, key any) (any, time.
 	return object, ttl, err
 }
 
-// Set defines data in Redis for given key identifier
+// Set defines data in Redis for given key identifier, dropping the version of
+// its previous value in the same transaction
 func (s *RedisClusterStore) Set(ctx context.Context, key any, value any, options ...Option) error {
+	cacheKey, err := s.key(key)
+	if err != nil {
+		return err
+	}
+
+	if err := checkRedisValue(value); err != nil {
+		return err
+	}
+
 	opts := applyOptionsWithDefault(s.options, options...)
 
-	err := s.clusclient.Set(ctx, key.(string), value, opts.expiration).Err()
+	_, err = s.clusclient.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.Set(ctx, cacheKey, value, opts.expiration)
+		pipe.Del(ctx, redisVersionKey(cacheKey))
+		return nil
+	})
 	if err != nil {
 		return err
 	}
@@ -85,27 +121,328 @@ func (s *RedisClusterStore) Set(ctx context.Context, key any, value any, options
 }
 
 func (s *RedisClusterStore) setTags(ctx context.Context, key any, tags []string) {
+	member, err := keyString(key)
+	if err != nil {
+		return
+	}
+
 	for _, tag := range tags {
 		tagKey := fmt.Sprintf(RedisTagPattern, tag)
-		s.clusclient.SAdd(ctx, tagKey, key.(string))
-		s.clusclient.Expire(ctx, tagKey, 720*time.Hour)
+		s.clusclient.SAdd(ctx, s.options.namespacedKey(tagKey), member)
+		s.clusclient.Expire(ctx, s.options.namespacedKey(tagKey), 720*time.Hour)
 	}
 }
 
 // Delete removes data from Redis for given key identifier
 func (s *RedisClusterStore) Delete(ctx context.Context, key any) error {
-	_, err := s.clusclient.Del(ctx, key.(string)).Result()
+	cacheKey, err := s.key(key)
+	if err != nil {
+		return err
+	}
+
+	_, err = s.clusclient.Del(ctx, cacheKey, redisVersionKey(cacheKey)).Result()
+	return err
+}
+
+// GetMany returns data stored from given keys using a pipeline, as keys
+// may belong to different hash slots
+func (s *RedisClusterStore) GetMany(ctx context.Context, keys []any) (map[any]any, error) {
+	values := make(map[any]any, len(keys))
+	if len(keys) == 0 {
+		return values, nil
+	}
+
+	cacheKeys, err := s.keys(keys)
+	if err != nil {
+		return nil, err
+	}
+
+	cmds := make([]*redis.StringCmd, len(keys))
+	_, err = s.clusclient.Pipelined(ctx, func(pipe redis.Pipeliner) error {
+		for i, cacheKey := range cacheKeys {
+			cmds[i] = pipe.Get(ctx, cacheKey)
+		}
+		return nil
+	})
+	if err != nil && err != redis.Nil {
+		return nil, err
+	}
+
+	for i, cmd := range cmds {
+		object, err := cmd.Result()
+		if err == redis.Nil {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		values[keys[i]] = object
+	}
+
+	return values, nil
+}
+
+// GetManyWithTTL returns data stored from given keys along with their
+// remaining TTL, using GET and TTL commands sent in a single pipeline
+func (s *RedisClusterStore) GetManyWithTTL(ctx context.Context, keys []any) (map[any]any, map[any]time.Duration, error) {
+	cacheKeys, err := s.keys(keys)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return getManyWithTTL(ctx, s.clusclient.Pipelined, keys, cacheKeys)
+}
+
+// SetMany defines data in Redis for given key identifiers using a
+// transactional pipeline, which also drops the versions of previous values
+func (s *RedisClusterStore) SetMany(ctx context.Context, items map[any]any, options ...Option) error {
+	opts := applyOptionsWithDefault(s.options, options...)
+
+	cacheItems, err := s.items(items)
+	if err != nil {
+		return err
+	}
+
+	_, err = s.clusclient.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		for cacheKey, value := range cacheItems {
+			pipe.Set(ctx, cacheKey, value, opts.expiration)
+			pipe.Del(ctx, redisVersionKey(cacheKey))
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	if tags := opts.tags; len(tags) > 0 {
+		for key := range items {
+			s.setTags(ctx, key, tags)
+		}
+	}
+
+	return nil
+}
+
+// DeleteMany removes data from Redis for given key identifiers using a pipeline
+func (s *RedisClusterStore) DeleteMany(ctx context.Context, keys []any) error {
+	if len(keys) == 0 {
+		return nil
+	}
+
+	cacheKeys, err := s.keys(keys)
+	if err != nil {
+		return err
+	}
+
+	_, err = s.clusclient.Pipelined(ctx, func(pipe redis.Pipeliner) error {
+		for _, cacheKey := range cacheKeys {
+			pipe.Del(ctx, cacheKey, redisVersionKey(cacheKey))
+		}
+		return nil
+	})
 	return err
 }
 
+// SetIfNotExists defines data in Redis cluster for given key identifier only when
+// the key does not exist yet, using the SETNX command
+func (s *RedisClusterStore) SetIfNotExists(ctx context.Context, key any, value any, options ...Option) error {
+	cacheKey, err := s.key(key)
+	if err != nil {
+		return err
+	}
+
+	if err := checkRedisValue(value); err != nil {
+		return err
+	}
+
+	opts := applyOptionsWithDefault(s.options, options...)
+
+	set, err := s.clusclient.SetNX(ctx, cacheKey, value, opts.expiration).Result()
+	if err != nil {
+		return err
+	}
+	if !set {
+		return AlreadyExistsWithCause(errors.New("value already exists in Redis cluster store"))
+	}
+
+	// Drop the version left by a previous value which expired meanwhile
+	if err := s.clusclient.Del(ctx, redisVersionKey(cacheKey)).Err(); err != nil {
+		return err
+	}
+
+	if tags := opts.tags; len(tags) > 0 {
+		s.setTags(ctx, key, tags)
+	}
+
+	return nil
+}
+
+// GetWithVersion returns data stored from a given key and its version, which
+// is kept in a separate key of the same hash slot until the value is written,
+// using a Lua script
+func (s *RedisClusterStore) GetWithVersion(ctx context.Context, key any) (any, Version, error) {
+	cacheKey, err := s.key(key)
+	if err != nil {
+		return nil, Version{}, err
+	}
+
+	return redisGetWithVersion(ctx, s.clusclient.Eval, cacheKey)
+}
+
+// SetIfVersion defines data in Redis cluster for given key identifier only when its
+// value has not been written since the given version, using a Lua script. The
+// current expiration is kept when none is given.
+func (s *RedisClusterStore) SetIfVersion(ctx context.Context, key any, value any, version Version, options ...Option) error {
+	current, ok := version.token.(string)
+	if !ok {
+		return ErrInvalidVersion
+	}
+
+	cacheKey, err := s.key(key)
+	if err != nil {
+		return err
+	}
+
+	if err := checkRedisValue(value); err != nil {
+		return err
+	}
+
+	opts := applyOptionsWithDefault(s.options, options...)
+
+	set, err := s.clusclient.Eval(ctx, redisSetIfVersionScript, []string{cacheKey, redisVersionKey(cacheKey)}, current, value, opts.expiration.Milliseconds()).Int()
+	if err != nil {
+		return err
+	}
+	if set == 0 {
+		return VersionConflictWithCause(errors.New("value has changed in Redis cluster store"))
+	}
+
+	if tags := opts.tags; len(tags) > 0 {
+		s.setTags(ctx, key, tags)
+	}
+
+	return nil
+}
+
+// DeleteIfVersion removes data from Redis cluster for given key identifier only when
+// its value has not been written since the given version, using a Lua script
+func (s *RedisClusterStore) DeleteIfVersion(ctx context.Context, key any, version Version) error {
+	current, ok := version.token.(string)
+	if !ok {
+		return ErrInvalidVersion
+	}
+
+	cacheKey, err := s.key(key)
+	if err != nil {
+		return err
+	}
+
+	deleted, err := s.clusclient.Eval(ctx, redisDeleteIfVersionScript, []string{cacheKey, redisVersionKey(cacheKey)}, current).Int()
+	if err != nil {
+		return err
+	}
+	if deleted == 0 {
+		return VersionConflictWithCause(errors.New("value has changed in Redis cluster store"))
+	}
+
+	return nil
+}
+
+// Exists returns whether the given key exists in Redis cluster, using the EXISTS command
+func (s *RedisClusterStore) Exists(ctx context.Context, key any) (bool, error) {
+	cacheKey, err := s.key(key)
+	if err != nil {
+		return false, err
+	}
+
+	count, err := s.clusclient.Exists(ctx, cacheKey).Result()
+	if err != nil {
+		return false, err
+	}
+
+	return count > 0, nil
+}
+
+// Touch updates the expiration of the given key in Redis cluster using the EXPIRE
+// command, or the PERSIST command when ttl is 0
+func (s *RedisClusterStore) Touch(ctx context.Context, key any, ttl time.Duration) error {
+	cacheKey, err := s.key(key)
+	if err != nil {
+		return err
+	}
+
+	var cmd *redis.BoolCmd
+	if ttl > 0 {
+		cmd = s.clusclient.Expire(ctx, cacheKey, ttl)
+	} else {
+		cmd = s.clusclient.Persist(ctx, cacheKey)
+	}
+
+	touched, err := cmd.Result()
+	if err != nil {
+		return err
+	}
+	if !touched {
+		// PERSIST also returns false for existing keys without expiration
+		if exists, err := s.Exists(ctx, key); err != nil || exists {
+			return err
+		}
+
+		return NotFoundWithCause(errors.New("value not found in Redis cluster store"))
+	}
+
+	return nil
+}
+
+// Increment atomically adds delta to the integer stored at the given key using
+// the INCRBY command. When an expiration is given, the key is first created with
+// it using SETNX so that following increments keep the initial expiration.
+// The version of the previous value is dropped in the same transaction as
+// INCRBY.
+func (s *RedisClusterStore) Increment(ctx context.Context, key any, delta int64, options ...Option) (int64, error) {
+	cacheKey, err := s.key(key)
+	if err != nil {
+		return 0, err
+	}
+
+	opts := applyOptionsWithDefault(s.options, options...)
+
+	if opts.expiration > 0 {
+		if err := s.clusclient.SetNX(ctx, cacheKey, 0, opts.expiration).Err(); err != nil {
+			return 0, err
+		}
+	}
+
+	var counter *redis.IntCmd
+	_, err = s.clusclient.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		counter = pipe.IncrBy(ctx, cacheKey, delta)
+		pipe.Del(ctx, redisVersionKey(cacheKey))
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return counter.Val(), nil
+}
+
 // Invalidate invalidates some cache data in Redis for given options
 func (s *RedisClusterStore) Invalidate(ctx context.Context, options ...InvalidateOption) error {
 	opts := applyInvalidateOptions(options...)
 
+	if pattern := opts.pattern; pattern != "" {
+		deleted, err := deleteMatching(ctx, s, pattern, RedisScanCount, s.unlink)
+		opts.addDeleted(deleted)
+		if err != nil {
+			return err
+		}
+	}
+
 	if tags := opts.tags; len(tags) > 0 {
 		for _, tag := range tags {
 			tagKey := fmt.Sprintf(RedisTagPattern, tag)
-			cacheKeys, err := s.clusclient.SMembers(ctx, tagKey).Result()
+			cacheKeys, err := s.clusclient.SMembers(ctx, s.options.namespacedKey(tagKey)).Result()
 			if err != nil {
 				continue
 			}
@@ -121,10 +458,123 @@ func (s *RedisClusterStore) Invalidate(ctx context.Context, options ...Invalidat
 	return nil
 }
 
-// Clear resets all data in the store
+// Scan calls fn for each key matching the given pattern using the SCAN command
+// on every master node of the cluster. Masters are scanned concurrently but fn
+// is never called concurrently. Only the keys of the store namespace are
+// scanned and given without their namespace prefix.
+func (s *RedisClusterStore) Scan(ctx context.Context, pattern string, fn func(key string) error) error {
+	var mu sync.Mutex
+	var scanErr error
+
+	fn = s.options.unnamespacedScan(fn)
+
+	return s.clusclient.ForEachMaster(ctx, func(ctx context.Context, client *redis.Client) error {
+		return redisScan(ctx, client, s.options.namespacedPattern(pattern), func(key string) error {
+			mu.Lock()
+			defer mu.Unlock()
+
+			// Stop scanning the other masters once fn has failed
+			if scanErr != nil {
+				return scanErr
+			}
+
+			scanErr = fn(key)
+
+			return scanErr
+		})
+	})
+}
+
+// key returns the Redis key of the given key identifier in the store namespace
+func (s *RedisClusterStore) key(key any) (string, error) {
+	k, err := keyString(key)
+	if err != nil {
+		return "", err
+	}
+
+	return s.options.namespacedKey(k), nil
+}
+
+// keys returns the Redis keys of the given key identifiers in the store namespace
+func (s *RedisClusterStore) keys(keys []any) ([]string, error) {
+	cacheKeys := make([]string, len(keys))
+	for i, key := range keys {
+		cacheKey, err := s.key(key)
+		if err != nil {
+			return nil, err
+		}
+		cacheKeys[i] = cacheKey
+	}
+
+	return cacheKeys, nil
+}
+
+// items returns the given items indexed by their Redis keys in the store
+// namespace after checking their values can be sent to Redis
+func (s *RedisClusterStore) items(items map[any]any) (map[string]any, error) {
+	cacheItems := make(map[string]any, len(items))
+	for key, value := range items {
+		cacheKey, err := s.key(key)
+		if err != nil {
+			return nil, err
+		}
+		if err := checkRedisValue(value); err != nil {
+			return nil, err
+		}
+		cacheItems[cacheKey] = value
+	}
+
+	return cacheItems, nil
+}
+
+// unlink removes the given keys of the store namespace along with their
+// versions in a pipeline, as keys of a batch may belong to different hash slots
+func (s *RedisClusterStore) unlink(ctx context.Context, keys []string) (int64, error) {
+	cmds := make([]*redis.IntCmd, len(keys))
+	_, err := s.clusclient.Pipelined(ctx, func(pipe redis.Pipeliner) error {
+		for i, key := range keys {
+			cacheKey := s.options.namespacedKey(key)
+			cmds[i] = pipe.Unlink(ctx, cacheKey)
+			pipe.Unlink(ctx, redisVersionKey(cacheKey))
+		}
+		return nil
+	})
+
+	var deleted int64
+	for _, cmd := range cmds {
+		if cmd != nil {
+			deleted += cmd.Val()
+		}
+	}
+
+	return deleted, err
+}
+
+// Clear resets all data of the store namespace using the SCAN and UNLINK
+// commands on every master node. Stores without namespace flush the whole
+// cluster when the WithFlushAll option is given and return ErrNamespaceRequired
+// otherwise.
 func (s *RedisClusterStore) Clear(ctx context.Context) error {
-	if err := s.clusclient.FlushAll(ctx).Err(); err != nil {
-		return err
+	if s.options.namespace == "" {
+		if !s.options.flushAll {
+			return ErrNamespaceRequired
+		}
+
+		if err := s.clusclient.FlushAll(ctx).Err(); err != nil {
+			return err
+		}
+
+		return nil
+	}
+
+	_, err := deleteMatching(ctx, s, "*", RedisScanCount, s.unlink)
+	return err
+}
+
+// Close closes the Redis Cluster client, when it can be closed
+func (s *RedisClusterStore) Close() error {
+	if closer, ok := s.clusclient.(io.Closer); ok {
+		return closer.Close()
 	}
 
 	return nil
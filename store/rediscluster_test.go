@@ -2,6 +2,7 @@ package store
 
 import (
 	"context"
+	"errors"
 	"testing"
 	"time"
 
@@ -54,8 +55,10 @@ func TestRedisClusterSet(t *testing.T) {
 	cacheKey := "my-key"
 	cacheValue := "my-cache-value"
 
+	pipe := newTestPipeliner(nil)
+
 	client := mocksStore.NewMockRedisClusterClientInterface(ctrl)
-	client.EXPECT().Set(ctx, "my-key", cacheValue, 5*time.Second).Return(&redis.StatusCmd{})
+	client.EXPECT().TxPipelined(ctx, gomock.Any()).DoAndReturn(pipe.pipelined)
 
 	store := NewRedisCluster(client, WithExpiration(6*time.Second))
 
@@ -64,6 +67,9 @@ func TestRedisClusterSet(t *testing.T) {
 
 	// Then
 	assert.Nil(t, err)
+	assert.Equal(t, map[string]any{"my-key": "my-cache-value"}, pipe.sets)
+	assert.Equal(t, map[string]time.Duration{"my-key": 5 * time.Second}, pipe.expirations)
+	assert.Equal(t, []string{"gocache_version_{my-key}"}, pipe.deletes)
 }
 
 func TestRedisClusterSetWhenNoOptionsGiven(t *testing.T) {
@@ -75,8 +81,10 @@ func TestRedisClusterSetWhenNoOptionsGiven(t *testing.T) {
 	cacheKey := "my-key"
 	cacheValue := "my-cache-value"
 
+	pipe := newTestPipeliner(nil)
+
 	client := mocksStore.NewMockRedisClusterClientInterface(ctrl)
-	client.EXPECT().Set(ctx, "my-key", cacheValue, 6*time.Second).Return(&redis.StatusCmd{})
+	client.EXPECT().TxPipelined(ctx, gomock.Any()).DoAndReturn(pipe.pipelined)
 
 	store := NewRedisCluster(client, WithExpiration(6*time.Second))
 
@@ -85,6 +93,9 @@ func TestRedisClusterSetWhenNoOptionsGiven(t *testing.T) {
 
 	// Then
 	assert.Nil(t, err)
+	assert.Equal(t, map[string]any{"my-key": "my-cache-value"}, pipe.sets)
+	assert.Equal(t, map[string]time.Duration{"my-key": 6 * time.Second}, pipe.expirations)
+	assert.Equal(t, []string{"gocache_version_{my-key}"}, pipe.deletes)
 }
 
 func TestRedisClusterSetWithTags(t *testing.T) {
@@ -96,8 +107,10 @@ func TestRedisClusterSetWithTags(t *testing.T) {
 	cacheKey := "my-key"
 	cacheValue := "my-cache-value"
 
+	pipe := newTestPipeliner(nil)
+
 	client := mocksStore.NewMockRedisClusterClientInterface(ctrl)
-	client.EXPECT().Set(ctx, cacheKey, cacheValue, time.Duration(0)).Return(&redis.StatusCmd{})
+	client.EXPECT().TxPipelined(ctx, gomock.Any()).DoAndReturn(pipe.pipelined)
 	client.EXPECT().SAdd(ctx, "gocache_tag_tag1", "my-key").Return(&redis.IntCmd{})
 	client.EXPECT().Expire(ctx, "gocache_tag_tag1", 720*time.Hour).Return(&redis.BoolCmd{})
 
@@ -108,6 +121,9 @@ func TestRedisClusterSetWithTags(t *testing.T) {
 
 	// Then
 	assert.Nil(t, err)
+	assert.Equal(t, map[string]any{"my-key": "my-cache-value"}, pipe.sets)
+	assert.Equal(t, map[string]time.Duration{"my-key": time.Duration(0)}, pipe.expirations)
+	assert.Equal(t, []string{"gocache_version_{my-key}"}, pipe.deletes)
 }
 
 func TestRedisClusterDelete(t *testing.T) {
@@ -119,7 +135,7 @@ func TestRedisClusterDelete(t *testing.T) {
 	cacheKey := "my-key"
 
 	client := mocksStore.NewMockRedisClusterClientInterface(ctrl)
-	client.EXPECT().Del(ctx, "my-key").Return(&redis.IntCmd{})
+	client.EXPECT().Del(ctx, "my-key", "gocache_version_{my-key}").Return(&redis.IntCmd{})
 
 	store := NewRedisCluster(client)
 
@@ -130,6 +146,153 @@ func TestRedisClusterDelete(t *testing.T) {
 	assert.Nil(t, err)
 }
 
+func TestRedisClusterGetMany(t *testing.T) {
+	// Given
+	ctrl := gomock.NewController(t)
+
+	ctx := context.Background()
+
+	pipe := newTestPipeliner(map[string]string{"key-1": "value 1"})
+
+	client := mocksStore.NewMockRedisClusterClientInterface(ctrl)
+	client.EXPECT().Pipelined(ctx, gomock.Any()).DoAndReturn(pipe.pipelined)
+
+	store := NewRedisCluster(client)
+
+	// When
+	values, err := store.GetMany(ctx, []any{"key-1", "key-2"})
+
+	// Then
+	assert.Nil(t, err)
+	assert.Equal(t, map[any]any{"key-1": "value 1"}, values)
+}
+
+func TestRedisClusterGetManyWithTTL(t *testing.T) {
+	// Given
+	ctrl := gomock.NewController(t)
+
+	ctx := context.Background()
+
+	pipe := newTestPipeliner(map[string]string{"key-1": "value 1"})
+
+	client := mocksStore.NewMockRedisClusterClientInterface(ctrl)
+	client.EXPECT().Pipelined(ctx, gomock.Any()).DoAndReturn(pipe.pipelined)
+
+	store := NewRedisCluster(client)
+
+	// When
+	values, ttls, err := store.GetManyWithTTL(ctx, []any{"key-1", "key-2"})
+
+	// Then
+	assert.Nil(t, err)
+	assert.Equal(t, map[any]any{"key-1": "value 1"}, values)
+	assert.Equal(t, map[any]time.Duration{"key-1": 5 * time.Second}, ttls)
+}
+
+func TestRedisClusterDeleteMany(t *testing.T) {
+	// Given
+	ctrl := gomock.NewController(t)
+
+	ctx := context.Background()
+
+	pipe := newTestPipeliner(nil)
+
+	client := mocksStore.NewMockRedisClusterClientInterface(ctrl)
+	client.EXPECT().Pipelined(ctx, gomock.Any()).DoAndReturn(pipe.pipelined)
+
+	store := NewRedisCluster(client)
+
+	// When
+	err := store.DeleteMany(ctx, []any{"key-1", "key-2"})
+
+	// Then
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"key-1", "gocache_version_{key-1}", "key-2", "gocache_version_{key-2}"}, pipe.deletes)
+}
+
+func TestRedisClusterSetIfNotExistsWhenAlreadyExists(t *testing.T) {
+	// Given
+	ctrl := gomock.NewController(t)
+
+	ctx := context.Background()
+
+	client := mocksStore.NewMockRedisClusterClientInterface(ctrl)
+	client.EXPECT().SetNX(ctx, "my-key", "my-value", time.Duration(0)).Return(redis.NewBoolResult(false, nil))
+
+	store := NewRedisCluster(client)
+
+	// When
+	err := store.SetIfNotExists(ctx, "my-key", "my-value")
+
+	// Then
+	assert.ErrorIs(t, err, &AlreadyExists{})
+}
+
+func TestRedisClusterGetWithVersion(t *testing.T) {
+	// Given
+	ctrl := gomock.NewController(t)
+
+	ctx := context.Background()
+
+	client := mocksStore.NewMockRedisClusterClientInterface(ctrl)
+	client.EXPECT().Eval(ctx, redisGetWithVersionScript, []string{"my-app:my-key", "gocache_version_{my-app:my-key}"}, gomock.Any()).
+		Return(redis.NewCmdResult([]any{"my-value", "version 1"}, nil))
+
+	store := NewRedisCluster(client, WithNamespace("my-app"))
+
+	// When
+	value, version, err := store.GetWithVersion(ctx, "my-key")
+
+	// Then
+	assert.Nil(t, err)
+	assert.Equal(t, "my-value", value)
+	assert.Equal(t, Version{token: "version 1"}, version)
+}
+
+func TestRedisClusterTouch(t *testing.T) {
+	// Given
+	ctrl := gomock.NewController(t)
+
+	ctx := context.Background()
+
+	client := mocksStore.NewMockRedisClusterClientInterface(ctrl)
+	client.EXPECT().Expire(ctx, "my-key", 10*time.Second).Return(redis.NewBoolResult(true, nil))
+
+	store := NewRedisCluster(client)
+
+	// When
+	err := store.Touch(ctx, "my-key", 10*time.Second)
+
+	// Then
+	assert.Nil(t, err)
+}
+
+func TestRedisClusterIncrementWithExpiration(t *testing.T) {
+	// Given
+	ctrl := gomock.NewController(t)
+
+	ctx := context.Background()
+
+	pipe := newTestPipeliner(nil)
+	pipe.increments["my-counter"] = 5
+
+	client := mocksStore.NewMockRedisClusterClientInterface(ctrl)
+	gomock.InOrder(
+		client.EXPECT().SetNX(ctx, "my-counter", 0, 10*time.Second).Return(redis.NewBoolResult(false, nil)),
+		client.EXPECT().TxPipelined(ctx, gomock.Any()).DoAndReturn(pipe.pipelined),
+	)
+
+	store := NewRedisCluster(client)
+
+	// When
+	counter, err := store.Increment(ctx, "my-counter", 2, WithExpiration(10*time.Second))
+
+	// Then
+	assert.Nil(t, err)
+	assert.Equal(t, int64(7), counter)
+	assert.Equal(t, []string{"gocache_version_{my-counter}"}, pipe.deletes)
+}
+
 func TestRedisClusterInvalidate(t *testing.T) {
 	// Given
 	ctrl := gomock.NewController(t)
@@ -151,6 +314,54 @@ func TestRedisClusterInvalidate(t *testing.T) {
 	assert.Nil(t, err)
 }
 
+func TestRedisClusterScanWhenError(t *testing.T) {
+	// Given
+	ctrl := gomock.NewController(t)
+
+	ctx := context.Background()
+
+	expectedErr := errors.New("unable to reach cluster")
+
+	client := mocksStore.NewMockRedisClusterClientInterface(ctrl)
+	client.EXPECT().ForEachMaster(ctx, gomock.Any()).Return(expectedErr)
+
+	store := NewRedisCluster(client)
+
+	// When
+	err := store.Scan(ctx, "my-*", func(key string) error {
+		return nil
+	})
+
+	// Then
+	assert.Equal(t, expectedErr, err)
+}
+
+func TestRedisClusterUnlink(t *testing.T) {
+	// Given
+	ctrl := gomock.NewController(t)
+
+	ctx := context.Background()
+
+	pipe := newTestPipeliner(map[string]string{"user:42:name": "John", "user:42:phone": "555-0100"})
+
+	client := mocksStore.NewMockRedisClusterClientInterface(ctrl)
+	client.EXPECT().Pipelined(ctx, gomock.Any()).DoAndReturn(pipe.pipelined)
+
+	store := NewRedisCluster(client)
+
+	// When
+	deleted, err := store.unlink(ctx, []string{"user:42:name", "user:42:email", "user:42:phone"})
+
+	// Then
+	assert.Nil(t, err)
+	assert.Equal(t, int64(2), deleted)
+	assert.Equal(t, []string{
+		"user:42:name", "gocache_version_{user:42:name}",
+		"user:42:email", "gocache_version_{user:42:email}",
+		"user:42:phone", "gocache_version_{user:42:phone}",
+	}, pipe.unlinks)
+}
+
 func TestRedisClusterClear(t *testing.T) {
 	// Given
 	ctrl := gomock.NewController(t)
@@ -160,7 +371,7 @@ func TestRedisClusterClear(t *testing.T) {
 	client := mocksStore.NewMockRedisClusterClientInterface(ctrl)
 	client.EXPECT().FlushAll(ctx).Return(&redis.StatusCmd{})
 
-	store := NewRedisCluster(client)
+	store := NewRedisCluster(client, WithFlushAll())
 
 	// When
 	err := store.Clear(ctx)
@@ -180,3 +391,43 @@ func TestRedisClusterGetType(t *testing.T) {
 	// When - Then
 	assert.Equal(t, RedisClusterType, store.GetType())
 }
+
+func TestRedisClusterClearWithoutNamespace(t *testing.T) {
+	// Given
+	ctrl := gomock.NewController(t)
+
+	ctx := context.Background()
+
+	client := mocksStore.NewMockRedisClusterClientInterface(ctrl)
+
+	store := NewRedisCluster(client)
+
+	// When
+	err := store.Clear(ctx)
+
+	// Then
+	assert.Equal(t, ErrNamespaceRequired, err)
+}
+
+func TestRedisClusterSetWithNamespace(t *testing.T) {
+	// Given
+	ctrl := gomock.NewController(t)
+
+	ctx := context.Background()
+
+	pipe := newTestPipeliner(nil)
+
+	client := mocksStore.NewMockRedisClusterClientInterface(ctrl)
+	client.EXPECT().TxPipelined(ctx, gomock.Any()).DoAndReturn(pipe.pipelined)
+
+	store := NewRedisCluster(client, WithNamespace("my-app"), WithExpiration(5*time.Second))
+
+	// When
+	err := store.Set(ctx, "my-key", "my-value")
+
+	// Then
+	assert.Nil(t, err)
+	assert.Equal(t, map[string]any{"my-app:my-key": "my-value"}, pipe.sets)
+	assert.Equal(t, map[string]time.Duration{"my-app:my-key": 5 * time.Second}, pipe.expirations)
+	assert.Equal(t, []string{"gocache_version_{my-app:my-key}"}, pipe.deletes)
+}
@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 	"strings"
+	"sync"
 	"time"
 )
 
@@ -18,30 +19,45 @@ const (
 // RistrettoClientInterface represents a dgraph-io/ristretto client
 type RistrettoClientInterface interface {
 	Get(key any) (any, bool)
+	GetTTL(key any) (time.Duration, bool)
 	SetWithTTL(key, value any, cost int64, ttl time.Duration) bool
 	Del(key any)
 	Clear()
+	Wait()
 }
 
 // RistrettoStore is a store for Ristretto (memory) library
 type RistrettoStore struct {
-	client  RistrettoClientInterface
-	options *options
+	// writeMu is held by all the writes so that the conditional ones, which
+	// read the current value before setting it, are atomic against them
+	writeMu  sync.Mutex
+	client   RistrettoClientInterface
+	options  *options
+	versions *keyVersions
 }
 
 // NewRistretto creates a new store to Ristretto (memory) library instance
 func NewRistretto(client RistrettoClientInterface, options ...Option) *RistrettoStore {
-	return &RistrettoStore{
+	store := &RistrettoStore{
 		client:  client,
 		options: applyOptions(options...),
 	}
+	store.versions = newKeyVersions(func(key any) bool {
+		_, exists := client.Get(key)
+		return exists
+	})
+
+	return store
 }
 
 // Get returns data stored from a given key
 func (s *RistrettoStore) Get(_ context.Context, key any) (any, error) {
-	var err error
+	k, err := ristrettoKey(key)
+	if err != nil {
+		return nil, err
+	}
 
-	value, exists := s.client.Get(key)
+	value, exists := s.client.Get(k)
 	if !exists {
 		err = NotFoundWithCause(errors.New("value not found in Ristretto store"))
 	}
@@ -52,18 +68,44 @@ func (s *RistrettoStore) Get(_ context.Context, key any) (any, error) {
 // GetWithTTL returns data stored from a given key and its corresponding TTL
 func (s *RistrettoStore) GetWithTTL(ctx context.Context, key any) (any, time.Duration, error) {
 	value, err := s.Get(ctx, key)
-	return value, 0, err
+	if err != nil {
+		return nil, 0, err
+	}
+
+	k, err := ristrettoKey(key)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	ttl, ok := s.client.GetTTL(k)
+	if !ok {
+		return nil, 0, NotFoundWithCause(errors.New("value not found in Ristretto store"))
+	}
+
+	return value, ttl, nil
 }
 
 // Set defines data in Ristretto memoey cache for given key identifier
 func (s *RistrettoStore) Set(ctx context.Context, key any, value any, options ...Option) error {
+	s.writeMu.Lock()
+	defer s.writeMu.Unlock()
+
+	return s.set(ctx, key, value, options...)
+}
+
+// set defines data for the given key identifier. s.writeMu must be held.
+func (s *RistrettoStore) set(ctx context.Context, key any, value any, options ...Option) error {
 	opts := applyOptionsWithDefault(s.options, options...)
 
-	var err error
+	k, err := ristrettoKey(key)
+	if err != nil {
+		return err
+	}
 
-	if set := s.client.SetWithTTL(key, value, opts.cost, opts.expiration); !set {
+	if set := s.client.SetWithTTL(k, value, opts.cost, opts.expiration); !set {
 		err = fmt.Errorf("An error has occurred while setting value '%v' on key '%v'", value, key)
 	}
+	s.versions.forget(ristrettoVersionKey(k))
 
 	if err != nil {
 		return err
@@ -77,6 +119,11 @@ func (s *RistrettoStore) Set(ctx context.Context, key any, value any, options ..
 }
 
 func (s *RistrettoStore) setTags(ctx context.Context, key any, tags []string) {
+	member, err := keyString(key)
+	if err != nil {
+		return
+	}
+
 	for _, tag := range tags {
 		tagKey := fmt.Sprintf(RistrettoTagPattern, tag)
 		cacheKeys := []string{}
@@ -89,30 +136,218 @@ func (s *RistrettoStore) setTags(ctx context.Context, key any, tags []string) {
 
 		alreadyInserted := false
 		for _, cacheKey := range cacheKeys {
-			if cacheKey == key.(string) {
+			if cacheKey == member {
 				alreadyInserted = true
 				break
 			}
 		}
 
 		if !alreadyInserted {
-			cacheKeys = append(cacheKeys, key.(string))
+			cacheKeys = append(cacheKeys, member)
 		}
 
-		s.Set(ctx, tagKey, []byte(strings.Join(cacheKeys, ",")), WithExpiration(720*time.Hour))
+		s.set(ctx, tagKey, []byte(strings.Join(cacheKeys, ",")), WithExpiration(720*time.Hour))
 	}
 }
 
 // Delete removes data in Ristretto memoey cache for given key identifier
 func (s *RistrettoStore) Delete(_ context.Context, key any) error {
-	s.client.Del(key)
+	k, err := ristrettoKey(key)
+	if err != nil {
+		return err
+	}
+
+	s.writeMu.Lock()
+	defer s.writeMu.Unlock()
+
+	s.client.Del(k)
+	s.versions.forget(ristrettoVersionKey(k))
+
 	return nil
 }
 
+// SetIfNotExists defines data in Ristretto memory cache for given key identifier
+// only when the key does not exist yet
+func (s *RistrettoStore) SetIfNotExists(ctx context.Context, key any, value any, options ...Option) error {
+	k, err := ristrettoKey(key)
+	if err != nil {
+		return err
+	}
+
+	s.writeMu.Lock()
+	defer s.writeMu.Unlock()
+
+	s.waitWrites()
+
+	if _, exists := s.client.Get(k); exists {
+		return AlreadyExistsWithCause(errors.New("value already exists in Ristretto store"))
+	}
+
+	if err := s.set(ctx, key, value, options...); err != nil {
+		return err
+	}
+
+	// Ristretto buffers writes: wait for the value to be visible to the next call
+	s.client.Wait()
+
+	return nil
+}
+
+// GetWithVersion returns data stored from a given key and its version, which
+// changes every time the key is written
+func (s *RistrettoStore) GetWithVersion(_ context.Context, key any) (any, Version, error) {
+	k, err := ristrettoKey(key)
+	if err != nil {
+		return nil, Version{}, err
+	}
+
+	// The value is read once the buffered writes are visible, so that it is
+	// not older than its version
+	s.writeMu.Lock()
+	defer s.writeMu.Unlock()
+
+	s.waitWrites()
+
+	version := s.versions.get(ristrettoVersionKey(k))
+
+	value, exists := s.client.Get(k)
+	if !exists {
+		return nil, Version{}, NotFoundWithCause(errors.New("value not found in Ristretto store"))
+	}
+
+	return value, version, nil
+}
+
+// SetIfVersion defines data in Ristretto memory cache for given key identifier only when it
+// has not been written since the given version was read
+func (s *RistrettoStore) SetIfVersion(ctx context.Context, key any, value any, version Version, options ...Option) error {
+	k, err := ristrettoKey(key)
+	if err != nil {
+		return err
+	}
+
+	s.writeMu.Lock()
+	defer s.writeMu.Unlock()
+
+	s.waitWrites()
+
+	if _, exists := s.client.Get(k); !exists || !s.versions.isCurrent(ristrettoVersionKey(k), version) {
+		return VersionConflictWithCause(errors.New("value has changed in Ristretto store"))
+	}
+
+	return s.set(ctx, key, value, options...)
+}
+
+// DeleteIfVersion removes data from Ristretto memory cache for given key identifier only when
+// it has not been written since the given version was read
+func (s *RistrettoStore) DeleteIfVersion(ctx context.Context, key any, version Version) error {
+	k, err := ristrettoKey(key)
+	if err != nil {
+		return err
+	}
+
+	s.writeMu.Lock()
+	defer s.writeMu.Unlock()
+
+	s.waitWrites()
+
+	if _, exists := s.client.Get(k); !exists || !s.versions.isCurrent(ristrettoVersionKey(k), version) {
+		return VersionConflictWithCause(errors.New("value has changed in Ristretto store"))
+	}
+
+	s.client.Del(k)
+	s.versions.forget(ristrettoVersionKey(k))
+
+	return nil
+}
+
+// Exists returns whether the given key exists in Ristretto memory cache
+func (s *RistrettoStore) Exists(_ context.Context, key any) (bool, error) {
+	k, err := ristrettoKey(key)
+	if err != nil {
+		return false, err
+	}
+
+	_, exists := s.client.Get(k)
+	return exists, nil
+}
+
+// Touch updates the expiration of the given key in Ristretto memory cache by
+// setting its value again
+func (s *RistrettoStore) Touch(_ context.Context, key any, ttl time.Duration) error {
+	k, err := ristrettoKey(key)
+	if err != nil {
+		return err
+	}
+
+	s.writeMu.Lock()
+	defer s.writeMu.Unlock()
+
+	s.waitWrites()
+
+	value, exists := s.client.Get(k)
+	if !exists {
+		return NotFoundWithCause(errors.New("value not found in Ristretto store"))
+	}
+
+	if set := s.client.SetWithTTL(k, value, s.options.cost, ttl); !set {
+		return fmt.Errorf("An error has occurred while touching key '%v'", key)
+	}
+
+	return nil
+}
+
+// Increment adds delta to the integer stored at the given key. Missing keys
+// are considered as 0 and created using the expiration option, while existing
+// ones keep their expiration.
+func (s *RistrettoStore) Increment(_ context.Context, key any, delta int64, options ...Option) (int64, error) {
+	k, err := ristrettoKey(key)
+	if err != nil {
+		return 0, err
+	}
+
+	s.writeMu.Lock()
+	defer s.writeMu.Unlock()
+
+	s.waitWrites()
+
+	opts := applyOptionsWithDefault(s.options, options...)
+
+	counter := delta
+	expiration := opts.expiration
+
+	if value, exists := s.client.Get(k); exists {
+		current, err := CounterValue(value)
+		if err != nil {
+			return 0, err
+		}
+		counter += current
+
+		expiration, _ = s.client.GetTTL(k)
+	}
+
+	set := s.client.SetWithTTL(k, counter, opts.cost, expiration)
+	s.versions.forget(ristrettoVersionKey(k))
+
+	if !set {
+		return 0, fmt.Errorf("An error has occurred while incrementing value on key '%v'", key)
+	}
+
+	// Ristretto buffers writes: wait for the counter to be visible to the next increment
+	s.client.Wait()
+
+	return counter, nil
+}
+
 // Invalidate invalidates some cache data in Redis for given options
 func (s *RistrettoStore) Invalidate(ctx context.Context, options ...InvalidateOption) error {
 	opts := applyInvalidateOptions(options...)
 
+	// Keys cannot be enumerated to be matched against a pattern
+	if opts.pattern != "" {
+		return ErrUnsupportedOperation
+	}
+
 	if tags := opts.tags; len(tags) > 0 {
 		for _, tag := range tags {
 			tagKey := fmt.Sprintf(RistrettoTagPattern, tag)
@@ -137,7 +372,51 @@ func (s *RistrettoStore) Invalidate(ctx context.Context, options ...InvalidateOp
 
 // Clear resets all data in the store
 func (s *RistrettoStore) Clear(_ context.Context) error {
+	s.writeMu.Lock()
+	defer s.writeMu.Unlock()
+
 	s.client.Clear()
+	s.versions.forgetAll()
+	return nil
+}
+
+// waitWrites waits for the values set before s.writeMu was acquired, which
+// Ristretto buffers, to be visible to the conditional writes. s.writeMu must
+// be held.
+func (s *RistrettoStore) waitWrites() {
+	s.client.Wait()
+}
+
+// ristrettoKey returns the given key when its type is handled by Ristretto,
+// which hashes strings, byte slices and integers, converting fmt.Stringer
+// implementations to strings
+func ristrettoKey(key any) (any, error) {
+	switch k := key.(type) {
+	case string, []byte, byte, int, int32, int64, uint32, uint64:
+		return key, nil
+	case fmt.Stringer:
+		return k.String(), nil
+	}
+
+	return nil, fmt.Errorf("%w: %T", ErrUnsupportedKeyType, key)
+}
+
+// ristrettoVersionKey returns the key under which the version of the given
+// Ristretto key is kept. Byte slices are hashed as strings by Ristretto.
+func ristrettoVersionKey(k any) any {
+	if b, ok := k.([]byte); ok {
+		return string(b)
+	}
+
+	return k
+}
+
+// Close stops the goroutines of the Ristretto client, when it can be closed
+func (s *RistrettoStore) Close() error {
+	if closer, ok := s.client.(interface{ Close() }); ok {
+		closer.Close()
+	}
+
 	return nil
 }
 
@@ -2,10 +2,13 @@ package store
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"sync"
 	"testing"
 	"time"
 
+	"github.com/dgraph-io/ristretto"
 	mocksStore "github.com/eko/gocache/v3/test/mocks/store/clients"
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/assert"
@@ -80,6 +83,7 @@ func TestRistrettoGetWithTTL(t *testing.T) {
 
 	client := mocksStore.NewMockRistrettoClientInterface(ctrl)
 	client.EXPECT().Get(cacheKey).Return(cacheValue, true)
+	client.EXPECT().GetTTL(cacheKey).Return(5*time.Second, true)
 
 	store := NewRistretto(client)
 
@@ -89,7 +93,7 @@ func TestRistrettoGetWithTTL(t *testing.T) {
 	// Then
 	assert.Nil(t, err)
 	assert.Equal(t, cacheValue, value)
-	assert.Equal(t, 0*time.Second, ttl)
+	assert.Equal(t, 5*time.Second, ttl)
 }
 
 func TestRistrettoGetWithTTLWhenError(t *testing.T) {
@@ -243,6 +247,183 @@ func TestRistrettoDelete(t *testing.T) {
 	assert.Nil(t, err)
 }
 
+func TestRistrettoSetIfNotExists(t *testing.T) {
+	// Given
+	ctrl := gomock.NewController(t)
+
+	ctx := context.Background()
+
+	client := mocksStore.NewMockRistrettoClientInterface(ctrl)
+	gomock.InOrder(
+		client.EXPECT().Wait(),
+		client.EXPECT().Get("my-key").Return(nil, false),
+		client.EXPECT().SetWithTTL("my-key", "my-value", int64(0), 5*time.Second).Return(true),
+		client.EXPECT().Wait(),
+	)
+
+	store := NewRistretto(client)
+
+	// When
+	err := store.SetIfNotExists(ctx, "my-key", "my-value", WithExpiration(5*time.Second))
+
+	// Then
+	assert.Nil(t, err)
+}
+
+// blockingRistrettoClient is a Ristretto client blocking its first Get until
+// release is closed
+type blockingRistrettoClient struct {
+	*ristretto.Cache
+	once    sync.Once
+	getting chan struct{}
+	release chan struct{}
+}
+
+func (c *blockingRistrettoClient) Get(key any) (any, bool) {
+	c.once.Do(func() {
+		close(c.getting)
+		<-c.release
+	})
+
+	return c.Cache.Get(key)
+}
+
+func newRistrettoTestClient(t *testing.T) *ristretto.Cache {
+	client, err := ristretto.NewCache(&ristretto.Config{
+		NumCounters: 1000,
+		MaxCost:     1 << 20,
+		BufferItems: 64,
+	})
+	assert.Nil(t, err)
+
+	return client
+}
+
+func TestRistrettoSetDuringSetIfNotExists(t *testing.T) {
+	// Given
+	ctx := context.Background()
+
+	client := &blockingRistrettoClient{
+		Cache:   newRistrettoTestClient(t),
+		getting: make(chan struct{}),
+		release: make(chan struct{}),
+	}
+	store := NewRistretto(client)
+
+	conditionalErr := make(chan error)
+	go func() {
+		conditionalErr <- store.SetIfNotExists(ctx, "my-key", "my-first-value")
+	}()
+	<-client.getting
+
+	// When
+	setErr := make(chan error)
+	go func() {
+		setErr <- store.Set(ctx, "my-key", "my-second-value")
+	}()
+
+	// Give the set a chance to run while the key is being checked
+	time.Sleep(50 * time.Millisecond)
+	close(client.release)
+
+	// Then
+	assert.Nil(t, <-conditionalErr)
+	assert.Nil(t, <-setErr)
+
+	client.Wait()
+
+	value, err := store.Get(ctx, "my-key")
+	assert.Nil(t, err)
+	assert.Equal(t, "my-second-value", value)
+}
+
+func TestRistrettoSetIfNotExistsAfterSet(t *testing.T) {
+	// Given
+	ctx := context.Background()
+
+	store := NewRistretto(newRistrettoTestClient(t))
+
+	assert.Nil(t, store.Set(ctx, "my-key", "my-first-value"))
+
+	// When
+	err := store.SetIfNotExists(ctx, "my-key", "my-second-value")
+
+	// Then
+	assert.True(t, errors.Is(err, &AlreadyExists{}))
+}
+
+func TestRistrettoDeleteIfVersion(t *testing.T) {
+	// Given
+	ctrl := gomock.NewController(t)
+
+	ctx := context.Background()
+
+	client := mocksStore.NewMockRistrettoClientInterface(ctrl)
+	gomock.InOrder(
+		client.EXPECT().Wait(),
+		client.EXPECT().Get("my-key").Return([]byte("my-value"), true),
+		client.EXPECT().Wait(),
+		client.EXPECT().Get("my-key").Return([]byte("my-value"), true),
+		client.EXPECT().Del("my-key"),
+	)
+
+	store := NewRistretto(client)
+
+	_, version, err := store.GetWithVersion(ctx, "my-key")
+	assert.Nil(t, err)
+
+	// When
+	err = store.DeleteIfVersion(ctx, "my-key", version)
+
+	// Then
+	assert.Nil(t, err)
+}
+
+func TestRistrettoSetIfVersionWhenValueIsSetBack(t *testing.T) {
+	// Given
+	ctx := context.Background()
+
+	store := NewRistretto(newRistrettoTestClient(t))
+	assert.Nil(t, store.Set(ctx, "my-key", "value 1"))
+
+	_, version, err := store.GetWithVersion(ctx, "my-key")
+	assert.Nil(t, err)
+
+	assert.Nil(t, store.Set(ctx, "my-key", "value 2"))
+	assert.Nil(t, store.Set(ctx, "my-key", "value 1"))
+
+	// When
+	err = store.SetIfVersion(ctx, "my-key", "value 3", version)
+
+	// Then
+	assert.ErrorIs(t, err, &VersionConflict{})
+}
+
+func TestRistrettoIncrement(t *testing.T) {
+	// Given
+	ctrl := gomock.NewController(t)
+
+	ctx := context.Background()
+
+	client := mocksStore.NewMockRistrettoClientInterface(ctrl)
+	gomock.InOrder(
+		client.EXPECT().Wait(),
+		client.EXPECT().Get("my-counter").Return(int64(2), true),
+		client.EXPECT().GetTTL("my-counter").Return(3*time.Second, true),
+		client.EXPECT().SetWithTTL("my-counter", int64(5), int64(0), 3*time.Second).Return(true),
+		client.EXPECT().Wait(),
+	)
+
+	store := NewRistretto(client)
+
+	// When
+	counter, err := store.Increment(ctx, "my-counter", 3, WithExpiration(10*time.Second))
+
+	// Then
+	assert.Nil(t, err)
+	assert.Equal(t, int64(5), counter)
+}
+
 func TestRistrettoInvalidate(t *testing.T) {
 	// Given
 	ctrl := gomock.NewController(t)
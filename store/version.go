@@ -0,0 +1,124 @@
+package store
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"sync"
+)
+
+// minKeyVersionsPrune is the number of tracked keys from which the versions
+// of the keys which do not exist anymore are pruned
+const minKeyVersionsPrune = 1024
+
+// Version is an opaque token identifying the version of a stored value. It is
+// returned by GetWithVersion and has to be given back to SetIfVersion.
+type Version struct {
+	token any
+}
+
+// newVersionToken returns a random version token, for the stores keeping the
+// versions of their values next to them
+func newVersionToken() (string, error) {
+	token := make([]byte, 16)
+	if _, err := rand.Read(token); err != nil {
+		return "", err
+	}
+
+	return hex.EncodeToString(token), nil
+}
+
+// keyVersions keeps the versions of the keys of the in-memory stores, which
+// cannot attach a version to their values. A key is given a version never used
+// before when it is read with its version, and loses it when it is written, so
+// that only the keys read with their version and not written since then are
+// tracked. Writes have to forget the versions of their keys once the values
+// have been written, while the versions have to be taken before reading the
+// values, so that a version never outlives the value it was read with.
+type keyVersions struct {
+	mu       sync.Mutex
+	sequence uint64
+	versions map[any]uint64
+	pruneAt  int
+	exists   func(key any) bool
+}
+
+// newKeyVersions returns the versions of the keys of a store, exists telling
+// whether a key is still in the store, as evicted and expired keys are not
+// written
+func newKeyVersions(exists func(key any) bool) *keyVersions {
+	return &keyVersions{
+		versions: make(map[any]uint64),
+		pruneAt:  minKeyVersionsPrune,
+		exists:   exists,
+	}
+}
+
+// get returns the current version of the given key
+func (v *keyVersions) get(key any) Version {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+
+	version, ok := v.versions[key]
+	if !ok {
+		v.prune()
+
+		v.sequence++
+		version = v.sequence
+		v.versions[key] = version
+	}
+
+	return Version{token: version}
+}
+
+// isCurrent returns whether the given version is still the one of the given key
+func (v *keyVersions) isCurrent(key any, version Version) bool {
+	token, ok := version.token.(uint64)
+	if !ok {
+		return false
+	}
+
+	v.mu.Lock()
+	defer v.mu.Unlock()
+
+	current, ok := v.versions[key]
+
+	return ok && current == token
+}
+
+// forget drops the versions of the given keys once they have been written
+func (v *keyVersions) forget(keys ...any) {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+
+	for _, key := range keys {
+		delete(v.versions, key)
+	}
+}
+
+// forgetAll drops the versions of all the keys once the store has been cleared
+func (v *keyVersions) forgetAll() {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+
+	v.versions = make(map[any]uint64)
+	v.pruneAt = minKeyVersionsPrune
+}
+
+// prune drops the versions of the keys which do not exist anymore once the
+// number of tracked keys has doubled since the last time. v.mu must be held.
+func (v *keyVersions) prune() {
+	if len(v.versions) < v.pruneAt {
+		return
+	}
+
+	for key := range v.versions {
+		if !v.exists(key) {
+			delete(v.versions, key)
+		}
+	}
+
+	v.pruneAt = 2 * len(v.versions)
+	if v.pruneAt < minKeyVersionsPrune {
+		v.pruneAt = minKeyVersionsPrune
+	}
+}
@@ -0,0 +1,46 @@
+package store
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestKeyVersions(t *testing.T) {
+	// Given
+	versions := newKeyVersions(func(key any) bool {
+		return true
+	})
+
+	version := versions.get("my-key")
+
+	// When
+	versions.forget("my-key")
+
+	// Then
+	assert.False(t, versions.isCurrent("my-key", version))
+	assert.NotEqual(t, version, versions.get("my-key"))
+	assert.True(t, versions.isCurrent("my-key", versions.get("my-key")))
+	assert.False(t, versions.isCurrent("other-key", versions.get("my-key")))
+	assert.False(t, versions.isCurrent("my-key", Version{token: "my-value"}))
+}
+
+func TestKeyVersionsPrunesMissingKeys(t *testing.T) {
+	// Given
+	versions := newKeyVersions(func(key any) bool {
+		return key == "my-key"
+	})
+
+	version := versions.get("my-key")
+	for i := 0; i < minKeyVersionsPrune-1; i++ {
+		versions.get(fmt.Sprintf("missing-key-%d", i))
+	}
+
+	// When
+	versions.get("other-key")
+
+	// Then
+	assert.Len(t, versions.versions, 2)
+	assert.True(t, versions.isCurrent("my-key", version))
+}
@@ -132,6 +132,490 @@ func (mr *MockCacheInterfaceMockRecorder[T]) Set(ctx, key, object interface{}, o
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Set", reflect.TypeOf((*MockCacheInterface[T])(nil).Set), varargs...)
 }
 
+// MockBulkCacheInterface is a mock of BulkCacheInterface interface.
+type MockBulkCacheInterface[T any] struct {
+	ctrl     *gomock.Controller
+	recorder *MockBulkCacheInterfaceMockRecorder[T]
+}
+
+// MockBulkCacheInterfaceMockRecorder is the mock recorder for MockBulkCacheInterface.
+type MockBulkCacheInterfaceMockRecorder[T any] struct {
+	mock *MockBulkCacheInterface[T]
+}
+
+// NewMockBulkCacheInterface creates a new mock instance.
+func NewMockBulkCacheInterface[T any](ctrl *gomock.Controller) *MockBulkCacheInterface[T] {
+	mock := &MockBulkCacheInterface[T]{ctrl: ctrl}
+	mock.recorder = &MockBulkCacheInterfaceMockRecorder[T]{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockBulkCacheInterface[T]) EXPECT() *MockBulkCacheInterfaceMockRecorder[T] {
+	return m.recorder
+}
+
+// DeleteMany mocks base method.
+func (m *MockBulkCacheInterface[T]) DeleteMany(ctx context.Context, keys []any) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteMany", ctx, keys)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteMany indicates an expected call of DeleteMany.
+func (mr *MockBulkCacheInterfaceMockRecorder[T]) DeleteMany(ctx, keys interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteMany", reflect.TypeOf((*MockBulkCacheInterface[T])(nil).DeleteMany), ctx, keys)
+}
+
+// GetMany mocks base method.
+func (m *MockBulkCacheInterface[T]) GetMany(ctx context.Context, keys []any) (map[any]T, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMany", ctx, keys)
+	ret0, _ := ret[0].(map[any]T)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMany indicates an expected call of GetMany.
+func (mr *MockBulkCacheInterfaceMockRecorder[T]) GetMany(ctx, keys interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMany", reflect.TypeOf((*MockBulkCacheInterface[T])(nil).GetMany), ctx, keys)
+}
+
+// SetMany mocks base method.
+func (m *MockBulkCacheInterface[T]) SetMany(ctx context.Context, items map[any]T, options ...store.Option) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, items}
+	for _, a := range options {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "SetMany", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetMany indicates an expected call of SetMany.
+func (mr *MockBulkCacheInterfaceMockRecorder[T]) SetMany(ctx, items interface{}, options ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, items}, options...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetMany", reflect.TypeOf((*MockBulkCacheInterface[T])(nil).SetMany), varargs...)
+}
+
+// MockBulkTTLCacheInterface is a mock of BulkTTLCacheInterface interface.
+type MockBulkTTLCacheInterface[T any] struct {
+	ctrl     *gomock.Controller
+	recorder *MockBulkTTLCacheInterfaceMockRecorder[T]
+}
+
+// MockBulkTTLCacheInterfaceMockRecorder is the mock recorder for MockBulkTTLCacheInterface.
+type MockBulkTTLCacheInterfaceMockRecorder[T any] struct {
+	mock *MockBulkTTLCacheInterface[T]
+}
+
+// NewMockBulkTTLCacheInterface creates a new mock instance.
+func NewMockBulkTTLCacheInterface[T any](ctrl *gomock.Controller) *MockBulkTTLCacheInterface[T] {
+	mock := &MockBulkTTLCacheInterface[T]{ctrl: ctrl}
+	mock.recorder = &MockBulkTTLCacheInterfaceMockRecorder[T]{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockBulkTTLCacheInterface[T]) EXPECT() *MockBulkTTLCacheInterfaceMockRecorder[T] {
+	return m.recorder
+}
+
+// GetManyWithTTL mocks base method.
+func (m *MockBulkTTLCacheInterface[T]) GetManyWithTTL(ctx context.Context, keys []any) (map[any]T, map[any]time.Duration, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetManyWithTTL", ctx, keys)
+	ret0, _ := ret[0].(map[any]T)
+	ret1, _ := ret[1].(map[any]time.Duration)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetManyWithTTL indicates an expected call of GetManyWithTTL.
+func (mr *MockBulkTTLCacheInterfaceMockRecorder[T]) GetManyWithTTL(ctx, keys interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetManyWithTTL", reflect.TypeOf((*MockBulkTTLCacheInterface[T])(nil).GetManyWithTTL), ctx, keys)
+}
+
+// MockConditionalCacheInterface is a mock of ConditionalCacheInterface interface.
+type MockConditionalCacheInterface[T any] struct {
+	ctrl     *gomock.Controller
+	recorder *MockConditionalCacheInterfaceMockRecorder[T]
+}
+
+// MockConditionalCacheInterfaceMockRecorder is the mock recorder for MockConditionalCacheInterface.
+type MockConditionalCacheInterfaceMockRecorder[T any] struct {
+	mock *MockConditionalCacheInterface[T]
+}
+
+// NewMockConditionalCacheInterface creates a new mock instance.
+func NewMockConditionalCacheInterface[T any](ctrl *gomock.Controller) *MockConditionalCacheInterface[T] {
+	mock := &MockConditionalCacheInterface[T]{ctrl: ctrl}
+	mock.recorder = &MockConditionalCacheInterfaceMockRecorder[T]{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockConditionalCacheInterface[T]) EXPECT() *MockConditionalCacheInterfaceMockRecorder[T] {
+	return m.recorder
+}
+
+// SetIfNotExists mocks base method.
+func (m *MockConditionalCacheInterface[T]) SetIfNotExists(ctx context.Context, key any, object T, options ...store.Option) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, key, object}
+	for _, a := range options {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "SetIfNotExists", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetIfNotExists indicates an expected call of SetIfNotExists.
+func (mr *MockConditionalCacheInterfaceMockRecorder[T]) SetIfNotExists(ctx, key, object interface{}, options ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, key, object}, options...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetIfNotExists", reflect.TypeOf((*MockConditionalCacheInterface[T])(nil).SetIfNotExists), varargs...)
+}
+
+// MockVersionedCacheInterface is a mock of VersionedCacheInterface interface.
+type MockVersionedCacheInterface[T any] struct {
+	ctrl     *gomock.Controller
+	recorder *MockVersionedCacheInterfaceMockRecorder[T]
+}
+
+// MockVersionedCacheInterfaceMockRecorder is the mock recorder for MockVersionedCacheInterface.
+type MockVersionedCacheInterfaceMockRecorder[T any] struct {
+	mock *MockVersionedCacheInterface[T]
+}
+
+// NewMockVersionedCacheInterface creates a new mock instance.
+func NewMockVersionedCacheInterface[T any](ctrl *gomock.Controller) *MockVersionedCacheInterface[T] {
+	mock := &MockVersionedCacheInterface[T]{ctrl: ctrl}
+	mock.recorder = &MockVersionedCacheInterfaceMockRecorder[T]{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockVersionedCacheInterface[T]) EXPECT() *MockVersionedCacheInterfaceMockRecorder[T] {
+	return m.recorder
+}
+
+// DeleteIfVersion mocks base method.
+func (m *MockVersionedCacheInterface[T]) DeleteIfVersion(ctx context.Context, key any, version store.Version) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteIfVersion", ctx, key, version)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteIfVersion indicates an expected call of DeleteIfVersion.
+func (mr *MockVersionedCacheInterfaceMockRecorder[T]) DeleteIfVersion(ctx, key, version interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteIfVersion", reflect.TypeOf((*MockVersionedCacheInterface[T])(nil).DeleteIfVersion), ctx, key, version)
+}
+
+// GetWithVersion mocks base method.
+func (m *MockVersionedCacheInterface[T]) GetWithVersion(ctx context.Context, key any) (T, store.Version, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetWithVersion", ctx, key)
+	ret0, _ := ret[0].(T)
+	ret1, _ := ret[1].(store.Version)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetWithVersion indicates an expected call of GetWithVersion.
+func (mr *MockVersionedCacheInterfaceMockRecorder[T]) GetWithVersion(ctx, key interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWithVersion", reflect.TypeOf((*MockVersionedCacheInterface[T])(nil).GetWithVersion), ctx, key)
+}
+
+// SetIfVersion mocks base method.
+func (m *MockVersionedCacheInterface[T]) SetIfVersion(ctx context.Context, key any, object T, version store.Version, options ...store.Option) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, key, object, version}
+	for _, a := range options {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "SetIfVersion", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetIfVersion indicates an expected call of SetIfVersion.
+func (mr *MockVersionedCacheInterfaceMockRecorder[T]) SetIfVersion(ctx, key, object, version interface{}, options ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, key, object, version}, options...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetIfVersion", reflect.TypeOf((*MockVersionedCacheInterface[T])(nil).SetIfVersion), varargs...)
+}
+
+// MockKeyCacheInterface is a mock of KeyCacheInterface interface.
+type MockKeyCacheInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockKeyCacheInterfaceMockRecorder
+}
+
+// MockKeyCacheInterfaceMockRecorder is the mock recorder for MockKeyCacheInterface.
+type MockKeyCacheInterfaceMockRecorder struct {
+	mock *MockKeyCacheInterface
+}
+
+// NewMockKeyCacheInterface creates a new mock instance.
+func NewMockKeyCacheInterface(ctrl *gomock.Controller) *MockKeyCacheInterface {
+	mock := &MockKeyCacheInterface{ctrl: ctrl}
+	mock.recorder = &MockKeyCacheInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockKeyCacheInterface) EXPECT() *MockKeyCacheInterfaceMockRecorder {
+	return m.recorder
+}
+
+// Exists mocks base method.
+func (m *MockKeyCacheInterface) Exists(ctx context.Context, key any) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Exists", ctx, key)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Exists indicates an expected call of Exists.
+func (mr *MockKeyCacheInterfaceMockRecorder) Exists(ctx, key interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Exists", reflect.TypeOf((*MockKeyCacheInterface)(nil).Exists), ctx, key)
+}
+
+// Touch mocks base method.
+func (m *MockKeyCacheInterface) Touch(ctx context.Context, key any, ttl time.Duration) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Touch", ctx, key, ttl)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Touch indicates an expected call of Touch.
+func (mr *MockKeyCacheInterfaceMockRecorder) Touch(ctx, key, ttl interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Touch", reflect.TypeOf((*MockKeyCacheInterface)(nil).Touch), ctx, key, ttl)
+}
+
+// MockScanCacheInterface is a mock of ScanCacheInterface interface.
+type MockScanCacheInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockScanCacheInterfaceMockRecorder
+}
+
+// MockScanCacheInterfaceMockRecorder is the mock recorder for MockScanCacheInterface.
+type MockScanCacheInterfaceMockRecorder struct {
+	mock *MockScanCacheInterface
+}
+
+// NewMockScanCacheInterface creates a new mock instance.
+func NewMockScanCacheInterface(ctrl *gomock.Controller) *MockScanCacheInterface {
+	mock := &MockScanCacheInterface{ctrl: ctrl}
+	mock.recorder = &MockScanCacheInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockScanCacheInterface) EXPECT() *MockScanCacheInterfaceMockRecorder {
+	return m.recorder
+}
+
+// Scan mocks base method.
+func (m *MockScanCacheInterface) Scan(ctx context.Context, pattern string, fn func(string) error) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Scan", ctx, pattern, fn)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Scan indicates an expected call of Scan.
+func (mr *MockScanCacheInterfaceMockRecorder) Scan(ctx, pattern, fn interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Scan", reflect.TypeOf((*MockScanCacheInterface)(nil).Scan), ctx, pattern, fn)
+}
+
+// MockCounterCacheInterface is a mock of CounterCacheInterface interface.
+type MockCounterCacheInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockCounterCacheInterfaceMockRecorder
+}
+
+// MockCounterCacheInterfaceMockRecorder is the mock recorder for MockCounterCacheInterface.
+type MockCounterCacheInterfaceMockRecorder struct {
+	mock *MockCounterCacheInterface
+}
+
+// NewMockCounterCacheInterface creates a new mock instance.
+func NewMockCounterCacheInterface(ctrl *gomock.Controller) *MockCounterCacheInterface {
+	mock := &MockCounterCacheInterface{ctrl: ctrl}
+	mock.recorder = &MockCounterCacheInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCounterCacheInterface) EXPECT() *MockCounterCacheInterfaceMockRecorder {
+	return m.recorder
+}
+
+// Decrement mocks base method.
+func (m *MockCounterCacheInterface) Decrement(ctx context.Context, key any, delta int64, options ...store.Option) (int64, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, key, delta}
+	for _, a := range options {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Decrement", varargs...)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Decrement indicates an expected call of Decrement.
+func (mr *MockCounterCacheInterfaceMockRecorder) Decrement(ctx, key, delta interface{}, options ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, key, delta}, options...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Decrement", reflect.TypeOf((*MockCounterCacheInterface)(nil).Decrement), varargs...)
+}
+
+// GetCounter mocks base method.
+func (m *MockCounterCacheInterface) GetCounter(ctx context.Context, key any) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCounter", ctx, key)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCounter indicates an expected call of GetCounter.
+func (mr *MockCounterCacheInterfaceMockRecorder) GetCounter(ctx, key interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCounter", reflect.TypeOf((*MockCounterCacheInterface)(nil).GetCounter), ctx, key)
+}
+
+// Increment mocks base method.
+func (m *MockCounterCacheInterface) Increment(ctx context.Context, key any, delta int64, options ...store.Option) (int64, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, key, delta}
+	for _, a := range options {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Increment", varargs...)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Increment indicates an expected call of Increment.
+func (mr *MockCounterCacheInterfaceMockRecorder) Increment(ctx, key, delta interface{}, options ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, key, delta}, options...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Increment", reflect.TypeOf((*MockCounterCacheInterface)(nil).Increment), varargs...)
+}
+
+// SetCounterIfNotExists mocks base method.
+func (m *MockCounterCacheInterface) SetCounterIfNotExists(ctx context.Context, key any, counter int64, options ...store.Option) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, key, counter}
+	for _, a := range options {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "SetCounterIfNotExists", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetCounterIfNotExists indicates an expected call of SetCounterIfNotExists.
+func (mr *MockCounterCacheInterfaceMockRecorder) SetCounterIfNotExists(ctx, key, counter interface{}, options ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, key, counter}, options...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetCounterIfNotExists", reflect.TypeOf((*MockCounterCacheInterface)(nil).SetCounterIfNotExists), varargs...)
+}
+
+// MockFlushCacheInterface is a mock of FlushCacheInterface interface.
+type MockFlushCacheInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockFlushCacheInterfaceMockRecorder
+}
+
+// MockFlushCacheInterfaceMockRecorder is the mock recorder for MockFlushCacheInterface.
+type MockFlushCacheInterfaceMockRecorder struct {
+	mock *MockFlushCacheInterface
+}
+
+// NewMockFlushCacheInterface creates a new mock instance.
+func NewMockFlushCacheInterface(ctrl *gomock.Controller) *MockFlushCacheInterface {
+	mock := &MockFlushCacheInterface{ctrl: ctrl}
+	mock.recorder = &MockFlushCacheInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockFlushCacheInterface) EXPECT() *MockFlushCacheInterfaceMockRecorder {
+	return m.recorder
+}
+
+// Flush mocks base method.
+func (m *MockFlushCacheInterface) Flush(ctx context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Flush", ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Flush indicates an expected call of Flush.
+func (mr *MockFlushCacheInterfaceMockRecorder) Flush(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Flush", reflect.TypeOf((*MockFlushCacheInterface)(nil).Flush), ctx)
+}
+
+// MockComposedCacheInterface is a mock of ComposedCacheInterface interface.
+type MockComposedCacheInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockComposedCacheInterfaceMockRecorder
+}
+
+// MockComposedCacheInterfaceMockRecorder is the mock recorder for MockComposedCacheInterface.
+type MockComposedCacheInterfaceMockRecorder struct {
+	mock *MockComposedCacheInterface
+}
+
+// NewMockComposedCacheInterface creates a new mock instance.
+func NewMockComposedCacheInterface(ctrl *gomock.Controller) *MockComposedCacheInterface {
+	mock := &MockComposedCacheInterface{ctrl: ctrl}
+	mock.recorder = &MockComposedCacheInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockComposedCacheInterface) EXPECT() *MockComposedCacheInterfaceMockRecorder {
+	return m.recorder
+}
+
+// GetComponents mocks base method.
+func (m *MockComposedCacheInterface) GetComponents() []any {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetComponents")
+	ret0, _ := ret[0].([]any)
+	return ret0
+}
+
+// GetComponents indicates an expected call of GetComponents.
+func (mr *MockComposedCacheInterfaceMockRecorder) GetComponents() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetComponents", reflect.TypeOf((*MockComposedCacheInterface)(nil).GetComponents))
+}
+
 // MockCacheKeyGenerator is a mock of CacheKeyGenerator interface.
 type MockCacheKeyGenerator struct {
 	ctrl     *gomock.Controller
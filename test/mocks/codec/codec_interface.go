@@ -65,6 +65,49 @@ func (mr *MockCodecInterfaceMockRecorder) Delete(ctx, key interface{}) *gomock.C
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockCodecInterface)(nil).Delete), ctx, key)
 }
 
+// DeleteIfVersion mocks base method.
+func (m *MockCodecInterface) DeleteIfVersion(ctx context.Context, key any, version store.Version) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteIfVersion", ctx, key, version)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteIfVersion indicates an expected call of DeleteIfVersion.
+func (mr *MockCodecInterfaceMockRecorder) DeleteIfVersion(ctx, key, version interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteIfVersion", reflect.TypeOf((*MockCodecInterface)(nil).DeleteIfVersion), ctx, key, version)
+}
+
+// DeleteMany mocks base method.
+func (m *MockCodecInterface) DeleteMany(ctx context.Context, keys []any) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteMany", ctx, keys)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteMany indicates an expected call of DeleteMany.
+func (mr *MockCodecInterfaceMockRecorder) DeleteMany(ctx, keys interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteMany", reflect.TypeOf((*MockCodecInterface)(nil).DeleteMany), ctx, keys)
+}
+
+// Exists mocks base method.
+func (m *MockCodecInterface) Exists(ctx context.Context, key any) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Exists", ctx, key)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Exists indicates an expected call of Exists.
+func (mr *MockCodecInterfaceMockRecorder) Exists(ctx, key interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Exists", reflect.TypeOf((*MockCodecInterface)(nil).Exists), ctx, key)
+}
+
 // Get mocks base method.
 func (m *MockCodecInterface) Get(ctx context.Context, key any) (any, error) {
 	m.ctrl.T.Helper()
@@ -80,6 +123,37 @@ func (mr *MockCodecInterfaceMockRecorder) Get(ctx, key interface{}) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockCodecInterface)(nil).Get), ctx, key)
 }
 
+// GetMany mocks base method.
+func (m *MockCodecInterface) GetMany(ctx context.Context, keys []any) (map[any]any, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMany", ctx, keys)
+	ret0, _ := ret[0].(map[any]any)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMany indicates an expected call of GetMany.
+func (mr *MockCodecInterfaceMockRecorder) GetMany(ctx, keys interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMany", reflect.TypeOf((*MockCodecInterface)(nil).GetMany), ctx, keys)
+}
+
+// GetManyWithTTL mocks base method.
+func (m *MockCodecInterface) GetManyWithTTL(ctx context.Context, keys []any) (map[any]any, map[any]time.Duration, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetManyWithTTL", ctx, keys)
+	ret0, _ := ret[0].(map[any]any)
+	ret1, _ := ret[1].(map[any]time.Duration)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetManyWithTTL indicates an expected call of GetManyWithTTL.
+func (mr *MockCodecInterfaceMockRecorder) GetManyWithTTL(ctx, keys interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetManyWithTTL", reflect.TypeOf((*MockCodecInterface)(nil).GetManyWithTTL), ctx, keys)
+}
+
 // GetStats mocks base method.
 func (m *MockCodecInterface) GetStats() *codec.Stats {
 	m.ctrl.T.Helper()
@@ -124,6 +198,42 @@ func (mr *MockCodecInterfaceMockRecorder) GetWithTTL(ctx, key interface{}) *gomo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWithTTL", reflect.TypeOf((*MockCodecInterface)(nil).GetWithTTL), ctx, key)
 }
 
+// GetWithVersion mocks base method.
+func (m *MockCodecInterface) GetWithVersion(ctx context.Context, key any) (any, store.Version, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetWithVersion", ctx, key)
+	ret0, _ := ret[0].(any)
+	ret1, _ := ret[1].(store.Version)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetWithVersion indicates an expected call of GetWithVersion.
+func (mr *MockCodecInterfaceMockRecorder) GetWithVersion(ctx, key interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWithVersion", reflect.TypeOf((*MockCodecInterface)(nil).GetWithVersion), ctx, key)
+}
+
+// Increment mocks base method.
+func (m *MockCodecInterface) Increment(ctx context.Context, key any, delta int64, options ...store.Option) (int64, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, key, delta}
+	for _, a := range options {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Increment", varargs...)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Increment indicates an expected call of Increment.
+func (mr *MockCodecInterfaceMockRecorder) Increment(ctx, key, delta interface{}, options ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, key, delta}, options...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Increment", reflect.TypeOf((*MockCodecInterface)(nil).Increment), varargs...)
+}
+
 // Invalidate mocks base method.
 func (m *MockCodecInterface) Invalidate(ctx context.Context, options ...store.InvalidateOption) error {
 	m.ctrl.T.Helper()
@@ -143,6 +253,20 @@ func (mr *MockCodecInterfaceMockRecorder) Invalidate(ctx interface{}, options ..
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Invalidate", reflect.TypeOf((*MockCodecInterface)(nil).Invalidate), varargs...)
 }
 
+// Scan mocks base method.
+func (m *MockCodecInterface) Scan(ctx context.Context, pattern string, fn func(string) error) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Scan", ctx, pattern, fn)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Scan indicates an expected call of Scan.
+func (mr *MockCodecInterfaceMockRecorder) Scan(ctx, pattern, fn interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Scan", reflect.TypeOf((*MockCodecInterface)(nil).Scan), ctx, pattern, fn)
+}
+
 // Set mocks base method.
 func (m *MockCodecInterface) Set(ctx context.Context, key, value any, options ...store.Option) error {
 	m.ctrl.T.Helper()
@@ -161,3 +285,74 @@ func (mr *MockCodecInterfaceMockRecorder) Set(ctx, key, value interface{}, optio
 	varargs := append([]interface{}{ctx, key, value}, options...)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Set", reflect.TypeOf((*MockCodecInterface)(nil).Set), varargs...)
 }
+
+// SetIfNotExists mocks base method.
+func (m *MockCodecInterface) SetIfNotExists(ctx context.Context, key, value any, options ...store.Option) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, key, value}
+	for _, a := range options {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "SetIfNotExists", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetIfNotExists indicates an expected call of SetIfNotExists.
+func (mr *MockCodecInterfaceMockRecorder) SetIfNotExists(ctx, key, value interface{}, options ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, key, value}, options...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetIfNotExists", reflect.TypeOf((*MockCodecInterface)(nil).SetIfNotExists), varargs...)
+}
+
+// SetIfVersion mocks base method.
+func (m *MockCodecInterface) SetIfVersion(ctx context.Context, key, value any, version store.Version, options ...store.Option) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, key, value, version}
+	for _, a := range options {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "SetIfVersion", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetIfVersion indicates an expected call of SetIfVersion.
+func (mr *MockCodecInterfaceMockRecorder) SetIfVersion(ctx, key, value, version interface{}, options ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, key, value, version}, options...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetIfVersion", reflect.TypeOf((*MockCodecInterface)(nil).SetIfVersion), varargs...)
+}
+
+// SetMany mocks base method.
+func (m *MockCodecInterface) SetMany(ctx context.Context, items map[any]any, options ...store.Option) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, items}
+	for _, a := range options {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "SetMany", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetMany indicates an expected call of SetMany.
+func (mr *MockCodecInterfaceMockRecorder) SetMany(ctx, items interface{}, options ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, items}, options...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetMany", reflect.TypeOf((*MockCodecInterface)(nil).SetMany), varargs...)
+}
+
+// Touch mocks base method.
+func (m *MockCodecInterface) Touch(ctx context.Context, key any, ttl time.Duration) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Touch", ctx, key, ttl)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Touch indicates an expected call of Touch.
+func (mr *MockCodecInterfaceMockRecorder) Touch(ctx, key, ttl interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Touch", reflect.TypeOf((*MockCodecInterface)(nil).Touch), ctx, key, ttl)
+}
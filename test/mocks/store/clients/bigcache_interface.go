@@ -7,6 +7,7 @@ package mocks
 import (
 	reflect "reflect"
 
+	bigcache "github.com/allegro/bigcache/v3"
 	gomock "github.com/golang/mock/gomock"
 )
 
@@ -62,6 +63,20 @@ func (mr *MockBigcacheClientInterfaceMockRecorder) Get(key interface{}) *gomock.
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockBigcacheClientInterface)(nil).Get), key)
 }
 
+// Iterator mocks base method.
+func (m *MockBigcacheClientInterface) Iterator() *bigcache.EntryInfoIterator {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Iterator")
+	ret0, _ := ret[0].(*bigcache.EntryInfoIterator)
+	return ret0
+}
+
+// Iterator indicates an expected call of Iterator.
+func (mr *MockBigcacheClientInterfaceMockRecorder) Iterator() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Iterator", reflect.TypeOf((*MockBigcacheClientInterface)(nil).Iterator))
+}
+
 // Reset mocks base method.
 func (m *MockBigcacheClientInterface) Reset() error {
 	m.ctrl.T.Helper()
@@ -7,6 +7,7 @@ package mocks
 import (
 	reflect "reflect"
 
+	freecache "github.com/coocood/freecache"
 	gomock "github.com/golang/mock/gomock"
 )
 
@@ -103,6 +104,20 @@ func (mr *MockFreecacheClientInterfaceMockRecorder) GetInt(key interface{}) *gom
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetInt", reflect.TypeOf((*MockFreecacheClientInterface)(nil).GetInt), key)
 }
 
+// NewIterator mocks base method.
+func (m *MockFreecacheClientInterface) NewIterator() *freecache.Iterator {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewIterator")
+	ret0, _ := ret[0].(*freecache.Iterator)
+	return ret0
+}
+
+// NewIterator indicates an expected call of NewIterator.
+func (mr *MockFreecacheClientInterfaceMockRecorder) NewIterator() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewIterator", reflect.TypeOf((*MockFreecacheClientInterface)(nil).NewIterator))
+}
+
 // Set mocks base method.
 func (m *MockFreecacheClientInterface) Set(key, value []byte, expireSeconds int) error {
 	m.ctrl.T.Helper()
@@ -145,3 +160,17 @@ func (mr *MockFreecacheClientInterfaceMockRecorder) TTL(key interface{}) *gomock
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TTL", reflect.TypeOf((*MockFreecacheClientInterface)(nil).TTL), key)
 }
+
+// Touch mocks base method.
+func (m *MockFreecacheClientInterface) Touch(key []byte, expireSeconds int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Touch", key, expireSeconds)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Touch indicates an expected call of Touch.
+func (mr *MockFreecacheClientInterfaceMockRecorder) Touch(key, expireSeconds interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Touch", reflect.TypeOf((*MockFreecacheClientInterface)(nil).Touch), key, expireSeconds)
+}
@@ -55,6 +55,44 @@ func (mr *MockRedisClientInterfaceMockRecorder) Del(ctx interface{}, keys ...int
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Del", reflect.TypeOf((*MockRedisClientInterface)(nil).Del), varargs...)
 }
 
+// Eval mocks base method.
+func (m *MockRedisClientInterface) Eval(ctx context.Context, script string, keys []string, args ...any) *redis.Cmd {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, script, keys}
+	for _, a := range args {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Eval", varargs...)
+	ret0, _ := ret[0].(*redis.Cmd)
+	return ret0
+}
+
+// Eval indicates an expected call of Eval.
+func (mr *MockRedisClientInterfaceMockRecorder) Eval(ctx, script, keys interface{}, args ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, script, keys}, args...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Eval", reflect.TypeOf((*MockRedisClientInterface)(nil).Eval), varargs...)
+}
+
+// Exists mocks base method.
+func (m *MockRedisClientInterface) Exists(ctx context.Context, keys ...string) *redis.IntCmd {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range keys {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Exists", varargs...)
+	ret0, _ := ret[0].(*redis.IntCmd)
+	return ret0
+}
+
+// Exists indicates an expected call of Exists.
+func (mr *MockRedisClientInterfaceMockRecorder) Exists(ctx interface{}, keys ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, keys...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Exists", reflect.TypeOf((*MockRedisClientInterface)(nil).Exists), varargs...)
+}
+
 // Expire mocks base method.
 func (m *MockRedisClientInterface) Expire(ctx context.Context, key string, expiration time.Duration) *redis.BoolCmd {
 	m.ctrl.T.Helper()
@@ -97,6 +135,68 @@ func (mr *MockRedisClientInterfaceMockRecorder) Get(ctx, key interface{}) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockRedisClientInterface)(nil).Get), ctx, key)
 }
 
+// IncrBy mocks base method.
+func (m *MockRedisClientInterface) IncrBy(ctx context.Context, key string, value int64) *redis.IntCmd {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IncrBy", ctx, key, value)
+	ret0, _ := ret[0].(*redis.IntCmd)
+	return ret0
+}
+
+// IncrBy indicates an expected call of IncrBy.
+func (mr *MockRedisClientInterfaceMockRecorder) IncrBy(ctx, key, value interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IncrBy", reflect.TypeOf((*MockRedisClientInterface)(nil).IncrBy), ctx, key, value)
+}
+
+// MGet mocks base method.
+func (m *MockRedisClientInterface) MGet(ctx context.Context, keys ...string) *redis.SliceCmd {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range keys {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "MGet", varargs...)
+	ret0, _ := ret[0].(*redis.SliceCmd)
+	return ret0
+}
+
+// MGet indicates an expected call of MGet.
+func (mr *MockRedisClientInterfaceMockRecorder) MGet(ctx interface{}, keys ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, keys...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MGet", reflect.TypeOf((*MockRedisClientInterface)(nil).MGet), varargs...)
+}
+
+// Persist mocks base method.
+func (m *MockRedisClientInterface) Persist(ctx context.Context, key string) *redis.BoolCmd {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Persist", ctx, key)
+	ret0, _ := ret[0].(*redis.BoolCmd)
+	return ret0
+}
+
+// Persist indicates an expected call of Persist.
+func (mr *MockRedisClientInterfaceMockRecorder) Persist(ctx, key interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Persist", reflect.TypeOf((*MockRedisClientInterface)(nil).Persist), ctx, key)
+}
+
+// Pipelined mocks base method.
+func (m *MockRedisClientInterface) Pipelined(ctx context.Context, fn func(redis.Pipeliner) error) ([]redis.Cmder, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Pipelined", ctx, fn)
+	ret0, _ := ret[0].([]redis.Cmder)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Pipelined indicates an expected call of Pipelined.
+func (mr *MockRedisClientInterfaceMockRecorder) Pipelined(ctx, fn interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Pipelined", reflect.TypeOf((*MockRedisClientInterface)(nil).Pipelined), ctx, fn)
+}
+
 // SAdd mocks base method.
 func (m *MockRedisClientInterface) SAdd(ctx context.Context, key string, members ...any) *redis.IntCmd {
 	m.ctrl.T.Helper()
@@ -130,6 +230,20 @@ func (mr *MockRedisClientInterfaceMockRecorder) SMembers(ctx, key interface{}) *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SMembers", reflect.TypeOf((*MockRedisClientInterface)(nil).SMembers), ctx, key)
 }
 
+// Scan mocks base method.
+func (m *MockRedisClientInterface) Scan(ctx context.Context, cursor uint64, match string, count int64) *redis.ScanCmd {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Scan", ctx, cursor, match, count)
+	ret0, _ := ret[0].(*redis.ScanCmd)
+	return ret0
+}
+
+// Scan indicates an expected call of Scan.
+func (mr *MockRedisClientInterfaceMockRecorder) Scan(ctx, cursor, match, count interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Scan", reflect.TypeOf((*MockRedisClientInterface)(nil).Scan), ctx, cursor, match, count)
+}
+
 // Set mocks base method.
 func (m *MockRedisClientInterface) Set(ctx context.Context, key string, values any, expiration time.Duration) *redis.StatusCmd {
 	m.ctrl.T.Helper()
@@ -144,6 +258,20 @@ func (mr *MockRedisClientInterfaceMockRecorder) Set(ctx, key, values, expiration
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Set", reflect.TypeOf((*MockRedisClientInterface)(nil).Set), ctx, key, values, expiration)
 }
 
+// SetNX mocks base method.
+func (m *MockRedisClientInterface) SetNX(ctx context.Context, key string, value any, expiration time.Duration) *redis.BoolCmd {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetNX", ctx, key, value, expiration)
+	ret0, _ := ret[0].(*redis.BoolCmd)
+	return ret0
+}
+
+// SetNX indicates an expected call of SetNX.
+func (mr *MockRedisClientInterfaceMockRecorder) SetNX(ctx, key, value, expiration interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetNX", reflect.TypeOf((*MockRedisClientInterface)(nil).SetNX), ctx, key, value, expiration)
+}
+
 // TTL mocks base method.
 func (m *MockRedisClientInterface) TTL(ctx context.Context, key string) *redis.DurationCmd {
 	m.ctrl.T.Helper()
@@ -157,3 +285,74 @@ func (mr *MockRedisClientInterfaceMockRecorder) TTL(ctx, key interface{}) *gomoc
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TTL", reflect.TypeOf((*MockRedisClientInterface)(nil).TTL), ctx, key)
 }
+
+// TxPipelined mocks base method.
+func (m *MockRedisClientInterface) TxPipelined(ctx context.Context, fn func(redis.Pipeliner) error) ([]redis.Cmder, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TxPipelined", ctx, fn)
+	ret0, _ := ret[0].([]redis.Cmder)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// TxPipelined indicates an expected call of TxPipelined.
+func (mr *MockRedisClientInterfaceMockRecorder) TxPipelined(ctx, fn interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TxPipelined", reflect.TypeOf((*MockRedisClientInterface)(nil).TxPipelined), ctx, fn)
+}
+
+// Unlink mocks base method.
+func (m *MockRedisClientInterface) Unlink(ctx context.Context, keys ...string) *redis.IntCmd {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range keys {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Unlink", varargs...)
+	ret0, _ := ret[0].(*redis.IntCmd)
+	return ret0
+}
+
+// Unlink indicates an expected call of Unlink.
+func (mr *MockRedisClientInterfaceMockRecorder) Unlink(ctx interface{}, keys ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, keys...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Unlink", reflect.TypeOf((*MockRedisClientInterface)(nil).Unlink), varargs...)
+}
+
+// MockredisScanner is a mock of redisScanner interface.
+type MockredisScanner struct {
+	ctrl     *gomock.Controller
+	recorder *MockredisScannerMockRecorder
+}
+
+// MockredisScannerMockRecorder is the mock recorder for MockredisScanner.
+type MockredisScannerMockRecorder struct {
+	mock *MockredisScanner
+}
+
+// NewMockredisScanner creates a new mock instance.
+func NewMockredisScanner(ctrl *gomock.Controller) *MockredisScanner {
+	mock := &MockredisScanner{ctrl: ctrl}
+	mock.recorder = &MockredisScannerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockredisScanner) EXPECT() *MockredisScannerMockRecorder {
+	return m.recorder
+}
+
+// Scan mocks base method.
+func (m *MockredisScanner) Scan(ctx context.Context, cursor uint64, match string, count int64) *redis.ScanCmd {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Scan", ctx, cursor, match, count)
+	ret0, _ := ret[0].(*redis.ScanCmd)
+	return ret0
+}
+
+// Scan indicates an expected call of Scan.
+func (mr *MockredisScannerMockRecorder) Scan(ctx, cursor, match, count interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Scan", reflect.TypeOf((*MockredisScanner)(nil).Scan), ctx, cursor, match, count)
+}
@@ -55,6 +55,44 @@ func (mr *MockRedisClusterClientInterfaceMockRecorder) Del(ctx interface{}, keys
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Del", reflect.TypeOf((*MockRedisClusterClientInterface)(nil).Del), varargs...)
 }
 
+// Eval mocks base method.
+func (m *MockRedisClusterClientInterface) Eval(ctx context.Context, script string, keys []string, args ...any) *redis.Cmd {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, script, keys}
+	for _, a := range args {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Eval", varargs...)
+	ret0, _ := ret[0].(*redis.Cmd)
+	return ret0
+}
+
+// Eval indicates an expected call of Eval.
+func (mr *MockRedisClusterClientInterfaceMockRecorder) Eval(ctx, script, keys interface{}, args ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, script, keys}, args...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Eval", reflect.TypeOf((*MockRedisClusterClientInterface)(nil).Eval), varargs...)
+}
+
+// Exists mocks base method.
+func (m *MockRedisClusterClientInterface) Exists(ctx context.Context, keys ...string) *redis.IntCmd {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range keys {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Exists", varargs...)
+	ret0, _ := ret[0].(*redis.IntCmd)
+	return ret0
+}
+
+// Exists indicates an expected call of Exists.
+func (mr *MockRedisClusterClientInterfaceMockRecorder) Exists(ctx interface{}, keys ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, keys...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Exists", reflect.TypeOf((*MockRedisClusterClientInterface)(nil).Exists), varargs...)
+}
+
 // Expire mocks base method.
 func (m *MockRedisClusterClientInterface) Expire(ctx context.Context, key string, expiration time.Duration) *redis.BoolCmd {
 	m.ctrl.T.Helper()
@@ -83,6 +121,20 @@ func (mr *MockRedisClusterClientInterfaceMockRecorder) FlushAll(ctx interface{})
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FlushAll", reflect.TypeOf((*MockRedisClusterClientInterface)(nil).FlushAll), ctx)
 }
 
+// ForEachMaster mocks base method.
+func (m *MockRedisClusterClientInterface) ForEachMaster(ctx context.Context, fn func(context.Context, *redis.Client) error) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ForEachMaster", ctx, fn)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ForEachMaster indicates an expected call of ForEachMaster.
+func (mr *MockRedisClusterClientInterfaceMockRecorder) ForEachMaster(ctx, fn interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ForEachMaster", reflect.TypeOf((*MockRedisClusterClientInterface)(nil).ForEachMaster), ctx, fn)
+}
+
 // Get mocks base method.
 func (m *MockRedisClusterClientInterface) Get(ctx context.Context, key string) *redis.StringCmd {
 	m.ctrl.T.Helper()
@@ -97,6 +149,49 @@ func (mr *MockRedisClusterClientInterfaceMockRecorder) Get(ctx, key interface{})
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockRedisClusterClientInterface)(nil).Get), ctx, key)
 }
 
+// IncrBy mocks base method.
+func (m *MockRedisClusterClientInterface) IncrBy(ctx context.Context, key string, value int64) *redis.IntCmd {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IncrBy", ctx, key, value)
+	ret0, _ := ret[0].(*redis.IntCmd)
+	return ret0
+}
+
+// IncrBy indicates an expected call of IncrBy.
+func (mr *MockRedisClusterClientInterfaceMockRecorder) IncrBy(ctx, key, value interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IncrBy", reflect.TypeOf((*MockRedisClusterClientInterface)(nil).IncrBy), ctx, key, value)
+}
+
+// Persist mocks base method.
+func (m *MockRedisClusterClientInterface) Persist(ctx context.Context, key string) *redis.BoolCmd {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Persist", ctx, key)
+	ret0, _ := ret[0].(*redis.BoolCmd)
+	return ret0
+}
+
+// Persist indicates an expected call of Persist.
+func (mr *MockRedisClusterClientInterfaceMockRecorder) Persist(ctx, key interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Persist", reflect.TypeOf((*MockRedisClusterClientInterface)(nil).Persist), ctx, key)
+}
+
+// Pipelined mocks base method.
+func (m *MockRedisClusterClientInterface) Pipelined(ctx context.Context, fn func(redis.Pipeliner) error) ([]redis.Cmder, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Pipelined", ctx, fn)
+	ret0, _ := ret[0].([]redis.Cmder)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Pipelined indicates an expected call of Pipelined.
+func (mr *MockRedisClusterClientInterfaceMockRecorder) Pipelined(ctx, fn interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Pipelined", reflect.TypeOf((*MockRedisClusterClientInterface)(nil).Pipelined), ctx, fn)
+}
+
 // SAdd mocks base method.
 func (m *MockRedisClusterClientInterface) SAdd(ctx context.Context, key string, members ...any) *redis.IntCmd {
 	m.ctrl.T.Helper()
@@ -144,6 +239,20 @@ func (mr *MockRedisClusterClientInterfaceMockRecorder) Set(ctx, key, values, exp
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Set", reflect.TypeOf((*MockRedisClusterClientInterface)(nil).Set), ctx, key, values, expiration)
 }
 
+// SetNX mocks base method.
+func (m *MockRedisClusterClientInterface) SetNX(ctx context.Context, key string, value any, expiration time.Duration) *redis.BoolCmd {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetNX", ctx, key, value, expiration)
+	ret0, _ := ret[0].(*redis.BoolCmd)
+	return ret0
+}
+
+// SetNX indicates an expected call of SetNX.
+func (mr *MockRedisClusterClientInterfaceMockRecorder) SetNX(ctx, key, value, expiration interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetNX", reflect.TypeOf((*MockRedisClusterClientInterface)(nil).SetNX), ctx, key, value, expiration)
+}
+
 // TTL mocks base method.
 func (m *MockRedisClusterClientInterface) TTL(ctx context.Context, key string) *redis.DurationCmd {
 	m.ctrl.T.Helper()
@@ -157,3 +266,37 @@ func (mr *MockRedisClusterClientInterfaceMockRecorder) TTL(ctx, key interface{})
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TTL", reflect.TypeOf((*MockRedisClusterClientInterface)(nil).TTL), ctx, key)
 }
+
+// TxPipelined mocks base method.
+func (m *MockRedisClusterClientInterface) TxPipelined(ctx context.Context, fn func(redis.Pipeliner) error) ([]redis.Cmder, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TxPipelined", ctx, fn)
+	ret0, _ := ret[0].([]redis.Cmder)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// TxPipelined indicates an expected call of TxPipelined.
+func (mr *MockRedisClusterClientInterfaceMockRecorder) TxPipelined(ctx, fn interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TxPipelined", reflect.TypeOf((*MockRedisClusterClientInterface)(nil).TxPipelined), ctx, fn)
+}
+
+// Unlink mocks base method.
+func (m *MockRedisClusterClientInterface) Unlink(ctx context.Context, keys ...string) *redis.IntCmd {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range keys {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Unlink", varargs...)
+	ret0, _ := ret[0].(*redis.IntCmd)
+	return ret0
+}
+
+// Unlink indicates an expected call of Unlink.
+func (mr *MockRedisClusterClientInterfaceMockRecorder) Unlink(ctx interface{}, keys ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, keys...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Unlink", reflect.TypeOf((*MockRedisClusterClientInterface)(nil).Unlink), varargs...)
+}
@@ -73,6 +73,21 @@ func (mr *MockRistrettoClientInterfaceMockRecorder) Get(key interface{}) *gomock
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockRistrettoClientInterface)(nil).Get), key)
 }
 
+// GetTTL mocks base method.
+func (m *MockRistrettoClientInterface) GetTTL(key any) (time.Duration, bool) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTTL", key)
+	ret0, _ := ret[0].(time.Duration)
+	ret1, _ := ret[1].(bool)
+	return ret0, ret1
+}
+
+// GetTTL indicates an expected call of GetTTL.
+func (mr *MockRistrettoClientInterfaceMockRecorder) GetTTL(key interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTTL", reflect.TypeOf((*MockRistrettoClientInterface)(nil).GetTTL), key)
+}
+
 // SetWithTTL mocks base method.
 func (m *MockRistrettoClientInterface) SetWithTTL(key, value any, cost int64, ttl time.Duration) bool {
 	m.ctrl.T.Helper()
@@ -86,3 +101,15 @@ func (mr *MockRistrettoClientInterfaceMockRecorder) SetWithTTL(key, value, cost,
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetWithTTL", reflect.TypeOf((*MockRistrettoClientInterface)(nil).SetWithTTL), key, value, cost, ttl)
 }
+
+// Wait mocks base method.
+func (m *MockRistrettoClientInterface) Wait() {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Wait")
+}
+
+// Wait indicates an expected call of Wait.
+func (mr *MockRistrettoClientInterfaceMockRecorder) Wait() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Wait", reflect.TypeOf((*MockRistrettoClientInterface)(nil).Wait))
+}
@@ -146,3 +146,382 @@ func (mr *MockStoreInterfaceMockRecorder) Set(ctx, key, value interface{}, optio
 	varargs := append([]interface{}{ctx, key, value}, options...)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Set", reflect.TypeOf((*MockStoreInterface)(nil).Set), varargs...)
 }
+
+// MockBulkStoreInterface is a mock of BulkStoreInterface interface.
+type MockBulkStoreInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockBulkStoreInterfaceMockRecorder
+}
+
+// MockBulkStoreInterfaceMockRecorder is the mock recorder for MockBulkStoreInterface.
+type MockBulkStoreInterfaceMockRecorder struct {
+	mock *MockBulkStoreInterface
+}
+
+// NewMockBulkStoreInterface creates a new mock instance.
+func NewMockBulkStoreInterface(ctrl *gomock.Controller) *MockBulkStoreInterface {
+	mock := &MockBulkStoreInterface{ctrl: ctrl}
+	mock.recorder = &MockBulkStoreInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockBulkStoreInterface) EXPECT() *MockBulkStoreInterfaceMockRecorder {
+	return m.recorder
+}
+
+// DeleteMany mocks base method.
+func (m *MockBulkStoreInterface) DeleteMany(ctx context.Context, keys []any) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteMany", ctx, keys)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteMany indicates an expected call of DeleteMany.
+func (mr *MockBulkStoreInterfaceMockRecorder) DeleteMany(ctx, keys interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteMany", reflect.TypeOf((*MockBulkStoreInterface)(nil).DeleteMany), ctx, keys)
+}
+
+// GetMany mocks base method.
+func (m *MockBulkStoreInterface) GetMany(ctx context.Context, keys []any) (map[any]any, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMany", ctx, keys)
+	ret0, _ := ret[0].(map[any]any)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMany indicates an expected call of GetMany.
+func (mr *MockBulkStoreInterfaceMockRecorder) GetMany(ctx, keys interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMany", reflect.TypeOf((*MockBulkStoreInterface)(nil).GetMany), ctx, keys)
+}
+
+// SetMany mocks base method.
+func (m *MockBulkStoreInterface) SetMany(ctx context.Context, items map[any]any, options ...store.Option) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, items}
+	for _, a := range options {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "SetMany", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetMany indicates an expected call of SetMany.
+func (mr *MockBulkStoreInterfaceMockRecorder) SetMany(ctx, items interface{}, options ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, items}, options...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetMany", reflect.TypeOf((*MockBulkStoreInterface)(nil).SetMany), varargs...)
+}
+
+// MockBulkTTLStoreInterface is a mock of BulkTTLStoreInterface interface.
+type MockBulkTTLStoreInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockBulkTTLStoreInterfaceMockRecorder
+}
+
+// MockBulkTTLStoreInterfaceMockRecorder is the mock recorder for MockBulkTTLStoreInterface.
+type MockBulkTTLStoreInterfaceMockRecorder struct {
+	mock *MockBulkTTLStoreInterface
+}
+
+// NewMockBulkTTLStoreInterface creates a new mock instance.
+func NewMockBulkTTLStoreInterface(ctrl *gomock.Controller) *MockBulkTTLStoreInterface {
+	mock := &MockBulkTTLStoreInterface{ctrl: ctrl}
+	mock.recorder = &MockBulkTTLStoreInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockBulkTTLStoreInterface) EXPECT() *MockBulkTTLStoreInterfaceMockRecorder {
+	return m.recorder
+}
+
+// GetManyWithTTL mocks base method.
+func (m *MockBulkTTLStoreInterface) GetManyWithTTL(ctx context.Context, keys []any) (map[any]any, map[any]time.Duration, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetManyWithTTL", ctx, keys)
+	ret0, _ := ret[0].(map[any]any)
+	ret1, _ := ret[1].(map[any]time.Duration)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetManyWithTTL indicates an expected call of GetManyWithTTL.
+func (mr *MockBulkTTLStoreInterfaceMockRecorder) GetManyWithTTL(ctx, keys interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetManyWithTTL", reflect.TypeOf((*MockBulkTTLStoreInterface)(nil).GetManyWithTTL), ctx, keys)
+}
+
+// MockConditionalStoreInterface is a mock of ConditionalStoreInterface interface.
+type MockConditionalStoreInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockConditionalStoreInterfaceMockRecorder
+}
+
+// MockConditionalStoreInterfaceMockRecorder is the mock recorder for MockConditionalStoreInterface.
+type MockConditionalStoreInterfaceMockRecorder struct {
+	mock *MockConditionalStoreInterface
+}
+
+// NewMockConditionalStoreInterface creates a new mock instance.
+func NewMockConditionalStoreInterface(ctrl *gomock.Controller) *MockConditionalStoreInterface {
+	mock := &MockConditionalStoreInterface{ctrl: ctrl}
+	mock.recorder = &MockConditionalStoreInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockConditionalStoreInterface) EXPECT() *MockConditionalStoreInterfaceMockRecorder {
+	return m.recorder
+}
+
+// SetIfNotExists mocks base method.
+func (m *MockConditionalStoreInterface) SetIfNotExists(ctx context.Context, key, value any, options ...store.Option) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, key, value}
+	for _, a := range options {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "SetIfNotExists", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetIfNotExists indicates an expected call of SetIfNotExists.
+func (mr *MockConditionalStoreInterfaceMockRecorder) SetIfNotExists(ctx, key, value interface{}, options ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, key, value}, options...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetIfNotExists", reflect.TypeOf((*MockConditionalStoreInterface)(nil).SetIfNotExists), varargs...)
+}
+
+// MockVersionedStoreInterface is a mock of VersionedStoreInterface interface.
+type MockVersionedStoreInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockVersionedStoreInterfaceMockRecorder
+}
+
+// MockVersionedStoreInterfaceMockRecorder is the mock recorder for MockVersionedStoreInterface.
+type MockVersionedStoreInterfaceMockRecorder struct {
+	mock *MockVersionedStoreInterface
+}
+
+// NewMockVersionedStoreInterface creates a new mock instance.
+func NewMockVersionedStoreInterface(ctrl *gomock.Controller) *MockVersionedStoreInterface {
+	mock := &MockVersionedStoreInterface{ctrl: ctrl}
+	mock.recorder = &MockVersionedStoreInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockVersionedStoreInterface) EXPECT() *MockVersionedStoreInterfaceMockRecorder {
+	return m.recorder
+}
+
+// DeleteIfVersion mocks base method.
+func (m *MockVersionedStoreInterface) DeleteIfVersion(ctx context.Context, key any, version store.Version) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteIfVersion", ctx, key, version)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteIfVersion indicates an expected call of DeleteIfVersion.
+func (mr *MockVersionedStoreInterfaceMockRecorder) DeleteIfVersion(ctx, key, version interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteIfVersion", reflect.TypeOf((*MockVersionedStoreInterface)(nil).DeleteIfVersion), ctx, key, version)
+}
+
+// GetWithVersion mocks base method.
+func (m *MockVersionedStoreInterface) GetWithVersion(ctx context.Context, key any) (any, store.Version, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetWithVersion", ctx, key)
+	ret0, _ := ret[0].(any)
+	ret1, _ := ret[1].(store.Version)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetWithVersion indicates an expected call of GetWithVersion.
+func (mr *MockVersionedStoreInterfaceMockRecorder) GetWithVersion(ctx, key interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWithVersion", reflect.TypeOf((*MockVersionedStoreInterface)(nil).GetWithVersion), ctx, key)
+}
+
+// SetIfVersion mocks base method.
+func (m *MockVersionedStoreInterface) SetIfVersion(ctx context.Context, key, value any, version store.Version, options ...store.Option) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, key, value, version}
+	for _, a := range options {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "SetIfVersion", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetIfVersion indicates an expected call of SetIfVersion.
+func (mr *MockVersionedStoreInterfaceMockRecorder) SetIfVersion(ctx, key, value, version interface{}, options ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, key, value, version}, options...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetIfVersion", reflect.TypeOf((*MockVersionedStoreInterface)(nil).SetIfVersion), varargs...)
+}
+
+// MockExistsStoreInterface is a mock of ExistsStoreInterface interface.
+type MockExistsStoreInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockExistsStoreInterfaceMockRecorder
+}
+
+// MockExistsStoreInterfaceMockRecorder is the mock recorder for MockExistsStoreInterface.
+type MockExistsStoreInterfaceMockRecorder struct {
+	mock *MockExistsStoreInterface
+}
+
+// NewMockExistsStoreInterface creates a new mock instance.
+func NewMockExistsStoreInterface(ctrl *gomock.Controller) *MockExistsStoreInterface {
+	mock := &MockExistsStoreInterface{ctrl: ctrl}
+	mock.recorder = &MockExistsStoreInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockExistsStoreInterface) EXPECT() *MockExistsStoreInterfaceMockRecorder {
+	return m.recorder
+}
+
+// Exists mocks base method.
+func (m *MockExistsStoreInterface) Exists(ctx context.Context, key any) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Exists", ctx, key)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Exists indicates an expected call of Exists.
+func (mr *MockExistsStoreInterfaceMockRecorder) Exists(ctx, key interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Exists", reflect.TypeOf((*MockExistsStoreInterface)(nil).Exists), ctx, key)
+}
+
+// MockTouchStoreInterface is a mock of TouchStoreInterface interface.
+type MockTouchStoreInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockTouchStoreInterfaceMockRecorder
+}
+
+// MockTouchStoreInterfaceMockRecorder is the mock recorder for MockTouchStoreInterface.
+type MockTouchStoreInterfaceMockRecorder struct {
+	mock *MockTouchStoreInterface
+}
+
+// NewMockTouchStoreInterface creates a new mock instance.
+func NewMockTouchStoreInterface(ctrl *gomock.Controller) *MockTouchStoreInterface {
+	mock := &MockTouchStoreInterface{ctrl: ctrl}
+	mock.recorder = &MockTouchStoreInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTouchStoreInterface) EXPECT() *MockTouchStoreInterfaceMockRecorder {
+	return m.recorder
+}
+
+// Touch mocks base method.
+func (m *MockTouchStoreInterface) Touch(ctx context.Context, key any, ttl time.Duration) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Touch", ctx, key, ttl)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Touch indicates an expected call of Touch.
+func (mr *MockTouchStoreInterfaceMockRecorder) Touch(ctx, key, ttl interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Touch", reflect.TypeOf((*MockTouchStoreInterface)(nil).Touch), ctx, key, ttl)
+}
+
+// MockScanStoreInterface is a mock of ScanStoreInterface interface.
+type MockScanStoreInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockScanStoreInterfaceMockRecorder
+}
+
+// MockScanStoreInterfaceMockRecorder is the mock recorder for MockScanStoreInterface.
+type MockScanStoreInterfaceMockRecorder struct {
+	mock *MockScanStoreInterface
+}
+
+// NewMockScanStoreInterface creates a new mock instance.
+func NewMockScanStoreInterface(ctrl *gomock.Controller) *MockScanStoreInterface {
+	mock := &MockScanStoreInterface{ctrl: ctrl}
+	mock.recorder = &MockScanStoreInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockScanStoreInterface) EXPECT() *MockScanStoreInterfaceMockRecorder {
+	return m.recorder
+}
+
+// Scan mocks base method.
+func (m *MockScanStoreInterface) Scan(ctx context.Context, pattern string, fn func(string) error) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Scan", ctx, pattern, fn)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Scan indicates an expected call of Scan.
+func (mr *MockScanStoreInterfaceMockRecorder) Scan(ctx, pattern, fn interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Scan", reflect.TypeOf((*MockScanStoreInterface)(nil).Scan), ctx, pattern, fn)
+}
+
+// MockCounterStoreInterface is a mock of CounterStoreInterface interface.
+type MockCounterStoreInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockCounterStoreInterfaceMockRecorder
+}
+
+// MockCounterStoreInterfaceMockRecorder is the mock recorder for MockCounterStoreInterface.
+type MockCounterStoreInterfaceMockRecorder struct {
+	mock *MockCounterStoreInterface
+}
+
+// NewMockCounterStoreInterface creates a new mock instance.
+func NewMockCounterStoreInterface(ctrl *gomock.Controller) *MockCounterStoreInterface {
+	mock := &MockCounterStoreInterface{ctrl: ctrl}
+	mock.recorder = &MockCounterStoreInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCounterStoreInterface) EXPECT() *MockCounterStoreInterfaceMockRecorder {
+	return m.recorder
+}
+
+// Increment mocks base method.
+func (m *MockCounterStoreInterface) Increment(ctx context.Context, key any, delta int64, options ...store.Option) (int64, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, key, delta}
+	for _, a := range options {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Increment", varargs...)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Increment indicates an expected call of Increment.
+func (mr *MockCounterStoreInterfaceMockRecorder) Increment(ctx, key, delta interface{}, options ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, key, delta}, options...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Increment", reflect.TypeOf((*MockCounterStoreInterface)(nil).Increment), varargs...)
+}